@@ -0,0 +1,261 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/hashing"
+)
+
+// # Bloom Filter - Data Structure
+
+// A Bloom Filter is a space efficient, probabilistic set. It answers "is x in the set?" with either "definitely not" or "probably yes". It never forgets a value that was added (no false negatives), but it may claim a value was added when it was not (false positives). In exchange it only takes a few bits per value, whatever the size of the values.
+
+// The filter is an array of m bits, all 0 at first, and k hash functions that each map a value to one of the m bits.
+// - Add: set the k bits of the value to 1.
+// - Test: the value may be in the set only if all its k bits are 1.
+
+// For n values and a target false positive rate p, the best sizes are:
+// - m = -n * ln(p) / (ln 2)^2 bits, about 9.6 bits per value for p = 1%.
+// - k = m/n * ln 2 hash functions, about 7 for p = 1%.
+
+// The k hash functions are derived from a single 64-bit hash h with double hashing (Kirsch and Mitzenmacher): the i-th bit is (h1 + i*h2) mod m, with h1 = h and h2 a remix of h.
+
+// ## Variants in this package:
+// - Filter: the classic Bloom filter.
+// - Counting: keeps a small counter instead of a bit, so values can also be removed.
+// - Scalable: a series of filters that grows as values are added, for when n is not known in advance.
+// - Typed: a Filter for string or integer keys, or keys of any type turned into bytes by a key function.
+
+// ## Usages:
+// - Skipping disk or network lookups for keys that do not exist (Cassandra, HBase, LevelDB, RocksDB).
+// - Deduplicating web scale streams: crawled URLs, seen events, recommended items.
+// - Malicious URL checks in browsers, weak password lists.
+
+var (
+	// ErrIncompatible is returned when combining filters that differ in size or number of hash functions.
+	ErrIncompatible = errors.New("bloom: incompatible filters")
+	// ErrInvalidFormat is returned by UnmarshalBinary for data that is not a serialized filter.
+	ErrInvalidFormat = errors.New("bloom: invalid serialized filter")
+)
+
+// Hasher returns the 64-bit hash of a key. Filters that are combined or serialized must use the same Hasher.
+type Hasher func(data []byte) uint64
+
+// DefaultHasher is the Hasher used by New. It is stable across processes and machines.
+func DefaultHasher(data []byte) uint64 {
+	return hashing.Sum64(data, 0)
+}
+
+// Filter is a classic Bloom filter.
+type Filter struct {
+	bits   []uint64
+	m      uint64
+	k      uint64
+	count  uint64
+	hasher Hasher
+}
+
+// New returns a Filter sized for n values with a false positive rate of about p.
+// n is at least 1 and p is clamped to (0, 1).
+func New(n uint64, p float64) *Filter {
+	return NewWithHasher(n, p, DefaultHasher)
+}
+
+// NewWithHasher is like New but hashes keys with h.
+func NewWithHasher(n uint64, p float64, h Hasher) *Filter {
+	m, k := EstimateParameters(n, p)
+	return NewWithSize(m, k, h)
+}
+
+// NewWithSize returns a Filter of m bits using k hash functions, both at least 1, hashing keys with h
+// (DefaultHasher if h is nil).
+func NewWithSize(m, k uint64, h Hasher) *Filter {
+	m, k = max(m, 1), max(k, 1)
+	if h == nil {
+		h = DefaultHasher
+	}
+	return &Filter{bits: make([]uint64, (m+63)/64), m: m, k: k, hasher: h}
+}
+
+// EstimateParameters returns the number of bits m and hash functions k for n values and a false positive rate p.
+func EstimateParameters(n uint64, p float64) (m, k uint64) {
+	n = max(n, 1)
+	p = clampRate(p)
+	m = uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k = uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	return max(m, 1), max(k, 1)
+}
+
+// Add - Adds a key to the filter.
+func (f *Filter) Add(key []byte) {
+	f.add(f.hasher(key))
+}
+
+// AddString - Adds a string key to the filter.
+func (f *Filter) AddString(key string) {
+	f.Add([]byte(key))
+}
+
+// Test - Reports whether the key may have been added. False means it definitely was not.
+func (f *Filter) Test(key []byte) bool {
+	return f.test(f.hasher(key))
+}
+
+// TestString - Reports whether the string key may have been added.
+func (f *Filter) TestString(key string) bool {
+	return f.Test([]byte(key))
+}
+
+// TestAndAdd - Reports whether the key may have been added, then adds it.
+func (f *Filter) TestAndAdd(key []byte) bool {
+	h := f.hasher(key)
+	present := f.test(h)
+	f.add(h)
+	return present
+}
+
+// Count - Returns the number of keys added, including repeated ones.
+func (f *Filter) Count() uint64 {
+	return f.count
+}
+
+// M - Returns the number of bits of the filter.
+func (f *Filter) M() uint64 {
+	return f.m
+}
+
+// K - Returns the number of hash functions of the filter.
+func (f *Filter) K() uint64 {
+	return f.k
+}
+
+// FalsePositiveRate - Returns the expected false positive rate for the number of keys added so far.
+func (f *Filter) FalsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(f.k)*float64(f.count)/float64(f.m)), float64(f.k))
+}
+
+// EstimatedCount - Estimates the number of distinct keys added from the number of bits set.
+func (f *Filter) EstimatedCount() float64 {
+	set := 0
+	for _, w := range f.bits {
+		set += bits.OnesCount64(w)
+	}
+	return -float64(f.m) / float64(f.k) * math.Log(1-float64(set)/float64(f.m))
+}
+
+// Clear - Removes all keys from the filter.
+func (f *Filter) Clear() {
+	clear(f.bits)
+	f.count = 0
+}
+
+// Union - Returns a new filter that holds the keys of both filters, which must have the same size and hash functions.
+func (f *Filter) Union(other *Filter) (*Filter, error) {
+	if !f.compatible(other) {
+		return nil, ErrIncompatible
+	}
+	union := f.clone()
+	for i, w := range other.bits {
+		union.bits[i] |= w
+	}
+	union.count += other.count
+	return union, nil
+}
+
+// Intersection - Returns a new filter that holds the keys present in both filters, which must have
+// the same size and hash functions. Its false positive rate is at most that of the larger input.
+func (f *Filter) Intersection(other *Filter) (*Filter, error) {
+	if !f.compatible(other) {
+		return nil, ErrIncompatible
+	}
+	intersection := f.clone()
+	for i, w := range other.bits {
+		intersection.bits[i] &= w
+	}
+	intersection.count = min(f.count, other.count)
+	return intersection, nil
+}
+
+// MarshalBinary - Encodes the filter: m, k and the count as uint64, then the bits, all little endian.
+// The Hasher is not encoded; the filter must be decoded with the same one.
+func (f *Filter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 24+8*len(f.bits))
+	data = binary.LittleEndian.AppendUint64(data, f.m)
+	data = binary.LittleEndian.AppendUint64(data, f.k)
+	data = binary.LittleEndian.AppendUint64(data, f.count)
+	for _, w := range f.bits {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replaces the filter with one encoded by MarshalBinary, keeping its Hasher
+// (DefaultHasher if it has none).
+func (f *Filter) UnmarshalBinary(data []byte) error {
+	if len(data) < 24 {
+		return ErrInvalidFormat
+	}
+	m := binary.LittleEndian.Uint64(data)
+	k := binary.LittleEndian.Uint64(data[8:])
+	count := binary.LittleEndian.Uint64(data[16:])
+	data = data[24:]
+	// Compare word counts, 8*((m+63)/64) would overflow for a huge m.
+	if m == 0 || k == 0 || len(data)%8 != 0 || (m+63)/64 != uint64(len(data))/8 || m > uint64(len(data))*8 {
+		return ErrInvalidFormat
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	f.bits, f.m, f.k, f.count = words, m, k, count
+	if f.hasher == nil {
+		f.hasher = DefaultHasher
+	}
+	return nil
+}
+
+func (f *Filter) add(h uint64) {
+	h2 := step(h)
+	for i := range f.k {
+		loc := (h + i*h2) % f.m
+		f.bits[loc/64] |= 1 << (loc % 64)
+	}
+	f.count++
+}
+
+func (f *Filter) test(h uint64) bool {
+	h2 := step(h)
+	for i := range f.k {
+		loc := (h + i*h2) % f.m
+		if f.bits[loc/64]&(1<<(loc%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Filter) compatible(other *Filter) bool {
+	return f.m == other.m && f.k == other.k
+}
+
+func (f *Filter) clone() *Filter {
+	c := *f
+	c.bits = append([]uint64(nil), f.bits...)
+	return &c
+}
+
+// step returns the second hash of double hashing: the i-th location of a key with hash h is (h + i*step(h)) mod m.
+func step(h uint64) uint64 {
+	return hashing.Mix64(h) | 1
+}
+
+// clampRate keeps a false positive rate inside (0, 1).
+func clampRate(p float64) float64 {
+	if math.IsNaN(p) {
+		return 0.01
+	}
+	return min(max(p, 1e-12), 0.999)
+}
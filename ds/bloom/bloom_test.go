@@ -0,0 +1,313 @@
+package bloom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestUnmarshalBinaryHugeM(t *testing.T) {
+	for _, m := range []uint64{math.MaxUint64, math.MaxUint64 - 62, 1 << 63, 65} {
+		data := binary.LittleEndian.AppendUint64(nil, m)
+		data = binary.LittleEndian.AppendUint64(data, 3)
+		data = binary.LittleEndian.AppendUint64(data, 0)
+		data = binary.LittleEndian.AppendUint64(data, 0)
+		var f Filter
+		if err := f.UnmarshalBinary(data); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("UnmarshalBinary(m=%d) = %v, want ErrInvalidFormat", m, err)
+		}
+	}
+	f := New(100, 0.01)
+	f.AddString("a")
+	data, _ := f.MarshalBinary()
+	var g Filter
+	if err := g.UnmarshalBinary(data); err != nil || !g.TestString("a") {
+		t.Fatalf("round trip failed: %v", err)
+	}
+	if err := g.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrInvalidFormat) {
+		t.Fatalf("UnmarshalBinary(truncated) = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestCountingRemoveSaturated(t *testing.T) {
+	// Saturated counters are never decremented, so the key still tests positive after Count() reaches 0.
+	c := NewCounting(100, 0.01)
+	for range math.MaxUint8 {
+		c.AddString("a")
+	}
+	for range math.MaxUint8 + 1 {
+		if !c.RemoveString("a") {
+			t.Fatal("Remove() = false for a key with saturated counters")
+		}
+	}
+	if c.Count() != 0 {
+		t.Fatalf("Count() = %d, want 0", c.Count())
+	}
+}
+
+// filter is the part of Filter, Counting and Scalable the tests below share.
+type filter interface {
+	Add(key []byte)
+	Test(key []byte) bool
+	Count() uint64
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+}
+
+// filters returns a new filter of each kind sized for n keys with a false positive rate of p.
+// The Scalable one starts ten times too small, so it has to grow.
+func filters(n uint64, p float64) map[string]filter {
+	return map[string]filter{
+		"Filter":   New(n, p),
+		"Counting": NewCounting(n, p),
+		"Scalable": NewScalable(n/10, p),
+	}
+}
+
+// key returns the i-th test key of a set, keys of different sets being different.
+func key(set string, i int) []byte {
+	return strconv.AppendInt([]byte(set), int64(i), 10)
+}
+
+// falsePositiveRate returns the share of trials keys that were never added, but f reports present.
+func falsePositiveRate(f interface{ Test([]byte) bool }, trials int) float64 {
+	positives := 0
+	for i := range trials {
+		if f.Test(key("absent-", i)) {
+			positives++
+		}
+	}
+	return float64(positives) / float64(trials)
+}
+
+func TestNoFalseNegatives(t *testing.T) {
+	const n = 5000
+	for name, f := range filters(n, 0.01) {
+		t.Run(name, func(t *testing.T) {
+			for i := range n {
+				f.Add(key("present-", i))
+			}
+			for i := range n {
+				if !f.Test(key("present-", i)) {
+					t.Fatalf("Test() = false for added key %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestFalsePositiveRate(t *testing.T) {
+	const n, trials = 10000, 200000
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		t.Run(fmt.Sprint(p), func(t *testing.T) {
+			m, k := EstimateParameters(n, p)
+			f := NewWithSize(m, k, nil)
+			for i := range n {
+				f.Add(key("present-", i))
+			}
+			got := falsePositiveRate(f, trials)
+			if got < p/1.5 || got > p*1.5 {
+				t.Fatalf("false positive rate %.5f with m=%d k=%d, want about %v", got, m, k, p)
+			}
+			if expected := f.FalsePositiveRate(); expected < p/1.2 || expected > p*1.2 {
+				t.Fatalf("FalsePositiveRate() = %.5f, want about %v", expected, p)
+			}
+		})
+	}
+}
+
+func TestScalableKeepsRate(t *testing.T) {
+	const p, trials = 0.01, 200000
+	s := NewScalable(100, p)
+	for i := range 50000 {
+		s.Add(key("present-", i))
+		if i%5000 == 4999 {
+			if got := falsePositiveRate(s, trials); got > p {
+				t.Fatalf("false positive rate %.5f with %d keys in %d filters, want at most %v", got, i+1, s.Filters(), p)
+			}
+		}
+	}
+	if s.Filters() < 5 {
+		t.Fatalf("Filters() = %d after 50000 keys with a first filter of 100, want it to have grown", s.Filters())
+	}
+}
+
+func TestUnionIntersection(t *testing.T) {
+	a, b := New(1000, 0.01), New(1000, 0.01)
+	for i := range 300 {
+		a.Add(key("a-", i))
+		b.Add(key("b-", i))
+		a.Add(key("both-", i))
+		b.Add(key("both-", i))
+	}
+
+	aBits, bBits := slices.Clone(a.bits), slices.Clone(b.bits)
+	union, err := a.Union(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intersection, err := a.Intersection(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, w := range union.bits {
+		if w != a.bits[i]|b.bits[i] || intersection.bits[i] != a.bits[i]&b.bits[i] {
+			t.Fatalf("word %d: Union() = %x and Intersection() = %x of %x and %x", i, w, intersection.bits[i], a.bits[i], b.bits[i])
+		}
+	}
+	if union.Count() != a.Count()+b.Count() || intersection.Count() != min(a.Count(), b.Count()) {
+		t.Fatalf("Count() of the Union = %d and of the Intersection = %d", union.Count(), intersection.Count())
+	}
+	onlyOne := 0
+	for i := range 300 {
+		if !union.Test(key("a-", i)) || !union.Test(key("b-", i)) || !union.Test(key("both-", i)) {
+			t.Fatalf("Union() misses key %d", i)
+		}
+		if !intersection.Test(key("both-", i)) {
+			t.Fatalf("Intersection() misses key both-%d", i)
+		}
+		if intersection.Test(key("a-", i)) {
+			onlyOne++
+		}
+	}
+	// The bits a key of a alone shares with b make it a false positive of the Intersection, a few percent at most.
+	if onlyOne > 30 {
+		t.Fatalf("Intersection() holds %d of the 300 keys only in the first filter", onlyOne)
+	}
+	if !slices.Equal(a.bits, aBits) || !slices.Equal(b.bits, bBits) {
+		t.Fatal("Union() or Intersection() changed an input")
+	}
+
+	for _, other := range []*Filter{New(2000, 0.01), NewWithSize(a.M(), a.K()+1, nil)} {
+		if _, err := a.Union(other); !errors.Is(err, ErrIncompatible) {
+			t.Fatalf("Union() of m=%d k=%d and m=%d k=%d = %v, want ErrIncompatible", a.M(), a.K(), other.M(), other.K(), err)
+		}
+		if _, err := a.Intersection(other); !errors.Is(err, ErrIncompatible) {
+			t.Fatalf("Intersection() of m=%d k=%d and m=%d k=%d = %v, want ErrIncompatible", a.M(), a.K(), other.M(), other.K(), err)
+		}
+	}
+}
+
+func TestCountingUnion(t *testing.T) {
+	a, b := NewCounting(1000, 0.01), NewCounting(1000, 0.01)
+	for i := range 300 {
+		a.Add(key("a-", i))
+		b.Add(key("b-", i))
+	}
+	union, err := a.Union(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if union.Count() != 600 {
+		t.Fatalf("Count() = %d, want 600", union.Count())
+	}
+	// Removing the keys of a leaves those of b, since the counters were summed.
+	for i := range 300 {
+		union.Remove(key("a-", i))
+	}
+	for i := range 300 {
+		if !union.Test(key("b-", i)) {
+			t.Fatalf("Union() lost key b-%d after removing the keys of the first filter", i)
+		}
+	}
+	if _, err := a.Union(NewCounting(2000, 0.01)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Union() of different sizes = %v, want ErrIncompatible", err)
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	const n = 2000
+	for name, f := range filters(n, 0.01) {
+		t.Run(name, func(t *testing.T) {
+			for i := range n {
+				f.Add(key("present-", i))
+			}
+			data, err := f.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := map[string]filter{"Filter": &Filter{}, "Counting": &Counting{}, "Scalable": &Scalable{}}[name]
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() = %v", err)
+			}
+			if again, _ := decoded.MarshalBinary(); !bytes.Equal(again, data) {
+				t.Fatal("encoding the decoded filter gives other bytes")
+			}
+			if decoded.Count() != f.Count() {
+				t.Fatalf("Count() = %d, want %d", decoded.Count(), f.Count())
+			}
+			for i := range n {
+				if !decoded.Test(key("present-", i)) {
+					t.Fatalf("the decoded filter misses key %d", i)
+				}
+			}
+			for i := range 1000 {
+				if decoded.Test(key("absent-", i)) != f.Test(key("absent-", i)) {
+					t.Fatalf("the decoded filter and the original disagree on absent key %d", i)
+				}
+			}
+			// The decoded filter keeps working like the original.
+			decoded.Add([]byte("new"))
+			f.Add([]byte("new"))
+			want, _ := f.MarshalBinary()
+			if again, _ := decoded.MarshalBinary(); !bytes.Equal(again, want) {
+				t.Fatal("adding the same key to the decoded filter and the original gives other bytes")
+			}
+
+			for _, size := range []int{0, 23, len(data) - 1} {
+				if err := decoded.UnmarshalBinary(data[:size]); !errors.Is(err, ErrInvalidFormat) {
+					t.Fatalf("UnmarshalBinary() of %d bytes = %v, want ErrInvalidFormat", size, err)
+				}
+			}
+		})
+	}
+}
+
+type userID int64
+
+func TestTyped(t *testing.T) {
+	names := NewString[string](1000, 0.01)
+	integers := NewInteger[userID](1000, 0.01)
+	for i := range 1000 {
+		names.Add(strconv.Itoa(i))
+		integers.Add(userID(i - 500))
+	}
+	for i := range 1000 {
+		if !names.Test(strconv.Itoa(i)) || !integers.Test(userID(i-500)) {
+			t.Fatalf("Test() = false for added key %d", i)
+		}
+	}
+	if got := falsePositiveRate(names.Filter, 100000); got > 0.015 {
+		t.Fatalf("false positive rate of NewString = %.4f, want about 0.01", got)
+	}
+	// String keys hash like AddString, integer keys like Add of their varint.
+	if !names.TestString("7") || !integers.Filter.Test(binary.AppendUvarint(nil, math.MaxUint64)) {
+		t.Fatal("the keys of a typed filter hash differently from the bytes it documents")
+	}
+	if names.TestAndAdd("new") || !names.TestAndAdd("new") {
+		t.Fatal("TestAndAdd() of a new key twice, want false then true")
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		names.Add("key")
+		names.Test("key")
+		integers.Add(-123456789)
+		integers.Test(math.MaxInt64)
+	}); allocs != 0 {
+		t.Fatalf("Add() and Test() of strings and integers allocate %v times, want 0", allocs)
+	}
+
+	type point struct{ X, Y int32 }
+	points := NewTyped(100, 0.01, func(p point) []byte {
+		return binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(nil, uint32(p.X)), uint32(p.Y))
+	})
+	points.Add(point{1, 2})
+	if !points.Test(point{1, 2}) || !points.Filter.Test([]byte{1, 0, 0, 0, 2, 0, 0, 0}) {
+		t.Fatal("NewTyped() does not hash the bytes of its key function")
+	}
+}
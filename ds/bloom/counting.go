@@ -0,0 +1,152 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Counting is a counting Bloom filter: every bit of the classic filter is replaced by an 8-bit counter,
+// so keys can be removed by decrementing their counters. It takes 8 times the memory of a Filter.
+// A counter that reaches 255 sticks there, since it no longer knows how many keys share it.
+type Counting struct {
+	counters []uint8
+	k        uint64
+	count    uint64
+	hasher   Hasher
+}
+
+// NewCounting returns a Counting filter sized for n values with a false positive rate of about p.
+func NewCounting(n uint64, p float64) *Counting {
+	return NewCountingWithHasher(n, p, DefaultHasher)
+}
+
+// NewCountingWithHasher is like NewCounting but hashes keys with h (DefaultHasher if h is nil).
+func NewCountingWithHasher(n uint64, p float64, h Hasher) *Counting {
+	if h == nil {
+		h = DefaultHasher
+	}
+	m, k := EstimateParameters(n, p)
+	return &Counting{counters: make([]uint8, m), k: k, hasher: h}
+}
+
+// Add - Adds a key to the filter.
+func (c *Counting) Add(key []byte) {
+	h := c.hasher(key)
+	h2 := step(h)
+	for i := range c.k {
+		loc := c.location(h, h2, i)
+		if c.counters[loc] < math.MaxUint8 {
+			c.counters[loc]++
+		}
+	}
+	c.count++
+}
+
+// AddString - Adds a string key to the filter.
+func (c *Counting) AddString(key string) {
+	c.Add([]byte(key))
+}
+
+// Remove - Removes a key that was added before and reports whether it may have been there.
+// Removing a key that was never added can remove other keys, so only remove keys you added.
+func (c *Counting) Remove(key []byte) bool {
+	h := c.hasher(key)
+	if !c.test(h) {
+		return false
+	}
+	h2 := step(h)
+	for i := range c.k {
+		loc := c.location(h, h2, i)
+		if c.counters[loc] < math.MaxUint8 {
+			c.counters[loc]--
+		}
+	}
+	// Saturated counters and false positives let Remove succeed more often than Add was called.
+	if c.count > 0 {
+		c.count--
+	}
+	return true
+}
+
+// RemoveString - Removes a string key that was added before.
+func (c *Counting) RemoveString(key string) bool {
+	return c.Remove([]byte(key))
+}
+
+// Test - Reports whether the key may be in the filter. False means it definitely is not.
+func (c *Counting) Test(key []byte) bool {
+	return c.test(c.hasher(key))
+}
+
+// TestString - Reports whether the string key may be in the filter.
+func (c *Counting) TestString(key string) bool {
+	return c.Test([]byte(key))
+}
+
+// Count - Returns the number of keys added minus the number removed.
+func (c *Counting) Count() uint64 {
+	return c.count
+}
+
+// Clear - Removes all keys from the filter.
+func (c *Counting) Clear() {
+	clear(c.counters)
+	c.count = 0
+}
+
+// Union - Returns a new filter whose counters are the sums of the counters of both filters,
+// which must have the same size and hash functions.
+func (c *Counting) Union(other *Counting) (*Counting, error) {
+	if len(c.counters) != len(other.counters) || c.k != other.k {
+		return nil, ErrIncompatible
+	}
+	union := &Counting{counters: make([]uint8, len(c.counters)), k: c.k, count: c.count + other.count, hasher: c.hasher}
+	for i, n := range c.counters {
+		union.counters[i] = uint8(min(int(n)+int(other.counters[i]), math.MaxUint8))
+	}
+	return union, nil
+}
+
+// MarshalBinary - Encodes the filter: m, k and the count as little endian uint64, then one byte per counter.
+// The Hasher is not encoded; the filter must be decoded with the same one.
+func (c *Counting) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 24+len(c.counters))
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(c.counters)))
+	data = binary.LittleEndian.AppendUint64(data, c.k)
+	data = binary.LittleEndian.AppendUint64(data, c.count)
+	return append(data, c.counters...), nil
+}
+
+// UnmarshalBinary - Replaces the filter with one encoded by MarshalBinary, keeping its Hasher
+// (DefaultHasher if it has none).
+func (c *Counting) UnmarshalBinary(data []byte) error {
+	if len(data) < 24 {
+		return ErrInvalidFormat
+	}
+	m := binary.LittleEndian.Uint64(data)
+	k := binary.LittleEndian.Uint64(data[8:])
+	count := binary.LittleEndian.Uint64(data[16:])
+	data = data[24:]
+	if m == 0 || k == 0 || uint64(len(data)) != m {
+		return ErrInvalidFormat
+	}
+	c.counters, c.k, c.count = append([]uint8(nil), data...), k, count
+	if c.hasher == nil {
+		c.hasher = DefaultHasher
+	}
+	return nil
+}
+
+func (c *Counting) test(h uint64) bool {
+	h2 := step(h)
+	for i := range c.k {
+		if c.counters[c.location(h, h2, i)] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Counting) location(h, h2, i uint64) uint64 {
+	return (h + i*h2) % uint64(len(c.counters))
+}
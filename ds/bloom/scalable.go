@@ -0,0 +1,153 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Scalable is a scalable Bloom filter (Almeida et al.). It starts as one Filter sized for n keys and
+// adds a filter twice as large each time the last one is full. Each new filter gets a tighter false positive
+// rate, so the overall rate stays below the p it was created with however many keys are added.
+type Scalable struct {
+	filters []*Filter
+	// capacity is the number of keys the last filter is sized for.
+	capacity uint64
+	// p is the false positive rate of the last filter.
+	p      float64
+	hasher Hasher
+}
+
+const (
+	// scalableGrowth is how much larger each new filter is than the previous one.
+	scalableGrowth = 2
+	// scalableTightening is how much lower the false positive rate of each new filter is.
+	// The rates form a geometric series p0 + p0*r + p0*r^2 + ... = p0 / (1 - r), so p0 is p * (1 - r).
+	scalableTightening = 0.8
+)
+
+// NewScalable returns a Scalable filter whose first filter is sized for n keys and whose overall
+// false positive rate stays below about p.
+func NewScalable(n uint64, p float64) *Scalable {
+	return NewScalableWithHasher(n, p, DefaultHasher)
+}
+
+// NewScalableWithHasher is like NewScalable but hashes keys with h (DefaultHasher if h is nil).
+func NewScalableWithHasher(n uint64, p float64, h Hasher) *Scalable {
+	if h == nil {
+		h = DefaultHasher
+	}
+	s := &Scalable{capacity: max(n, 1), p: clampRate(p) * (1 - scalableTightening), hasher: h}
+	s.filters = []*Filter{NewWithHasher(s.capacity, s.p, h)}
+	return s
+}
+
+// Add - Adds a key to the filter, growing it when its last filter is full.
+// Keys that may already be in the filter are not added again, so they do not use up capacity.
+func (s *Scalable) Add(key []byte) {
+	h := s.hasher(key)
+	for _, f := range s.filters {
+		if f.test(h) {
+			return
+		}
+	}
+	last := s.filters[len(s.filters)-1]
+	if last.count >= s.capacity {
+		s.capacity *= scalableGrowth
+		s.p *= scalableTightening
+		last = NewWithHasher(s.capacity, s.p, s.hasher)
+		s.filters = append(s.filters, last)
+	}
+	last.add(h)
+}
+
+// AddString - Adds a string key to the filter.
+func (s *Scalable) AddString(key string) {
+	s.Add([]byte(key))
+}
+
+// Test - Reports whether the key may have been added. False means it definitely was not.
+func (s *Scalable) Test(key []byte) bool {
+	h := s.hasher(key)
+	for _, f := range s.filters {
+		if f.test(h) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestString - Reports whether the string key may have been added.
+func (s *Scalable) TestString(key string) bool {
+	return s.Test([]byte(key))
+}
+
+// Count - Returns the number of distinct keys added, as far as the filter can tell.
+func (s *Scalable) Count() uint64 {
+	count := uint64(0)
+	for _, f := range s.filters {
+		count += f.count
+	}
+	return count
+}
+
+// Filters - Returns the number of filters the Scalable filter has grown to.
+func (s *Scalable) Filters() int {
+	return len(s.filters)
+}
+
+// MarshalBinary - Encodes the filter: the capacity and false positive rate of the last filter and the
+// number of filters as little endian uint64, then each Filter as its length followed by its MarshalBinary.
+func (s *Scalable) MarshalBinary() ([]byte, error) {
+	var data []byte
+	data = binary.LittleEndian.AppendUint64(data, s.capacity)
+	data = binary.LittleEndian.AppendUint64(data, math.Float64bits(s.p))
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(s.filters)))
+	for _, f := range s.filters {
+		b, err := f.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		data = binary.LittleEndian.AppendUint64(data, uint64(len(b)))
+		data = append(data, b...)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replaces the filter with one encoded by MarshalBinary, keeping its Hasher
+// (DefaultHasher if it has none).
+func (s *Scalable) UnmarshalBinary(data []byte) error {
+	if len(data) < 24 {
+		return ErrInvalidFormat
+	}
+	if s.hasher == nil {
+		s.hasher = DefaultHasher
+	}
+	capacity := binary.LittleEndian.Uint64(data)
+	p := math.Float64frombits(binary.LittleEndian.Uint64(data[8:]))
+	n := binary.LittleEndian.Uint64(data[16:])
+	data = data[24:]
+	if capacity == 0 || n == 0 || n > uint64(len(data))/8 {
+		return ErrInvalidFormat
+	}
+	filters := make([]*Filter, n)
+	for i := range filters {
+		if len(data) < 8 {
+			return ErrInvalidFormat
+		}
+		size := binary.LittleEndian.Uint64(data)
+		data = data[8:]
+		if size > uint64(len(data)) {
+			return ErrInvalidFormat
+		}
+		filters[i] = &Filter{hasher: s.hasher}
+		if err := filters[i].UnmarshalBinary(data[:size]); err != nil {
+			return err
+		}
+		data = data[size:]
+	}
+	if len(data) != 0 {
+		return ErrInvalidFormat
+	}
+	s.filters, s.capacity, s.p = filters, capacity, p
+	return nil
+}
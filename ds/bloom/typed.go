@@ -0,0 +1,49 @@
+package bloom
+
+import "github.com/rama-kairi/ds-algo/ds/internal/hashing"
+
+// Integer is the set of integer types NewInteger accepts.
+type Integer = hashing.Integer
+
+// Typed is a Filter for keys of type T, each hashed to the 64-bit hash the filter uses.
+type Typed[T any] struct {
+	*Filter
+	hash func(T) uint64
+}
+
+// NewTyped returns a Typed filter sized for n keys with a false positive rate of about p,
+// hashing the bytes key returns for each key. Keys that are equal must give the same bytes.
+func NewTyped[T any](n uint64, p float64, key func(T) []byte) *Typed[T] {
+	t := &Typed[T]{Filter: New(n, p)}
+	t.hash = func(v T) uint64 { return t.hasher(key(v)) }
+	return t
+}
+
+// NewString returns a Typed filter for string keys, hashed as AddString hashes them.
+func NewString[T ~string](n uint64, p float64) *Typed[T] {
+	return &Typed[T]{Filter: New(n, p), hash: func(v T) uint64 { return hashing.Sum64String(string(v), 0) }}
+}
+
+// NewInteger returns a Typed filter for integer keys, hashing the unsigned varint of each key
+// (negative keys sign extended to 64 bits) as Add hashes bytes.
+func NewInteger[T Integer](n uint64, p float64) *Typed[T] {
+	return &Typed[T]{Filter: New(n, p), hash: func(v T) uint64 { return hashing.SumInteger(v, 0) }}
+}
+
+// Add - Adds a key to the filter.
+func (t *Typed[T]) Add(key T) {
+	t.add(t.hash(key))
+}
+
+// Test - Reports whether the key may have been added. False means it definitely was not.
+func (t *Typed[T]) Test(key T) bool {
+	return t.test(t.hash(key))
+}
+
+// TestAndAdd - Reports whether the key may have been added, then adds it.
+func (t *Typed[T]) TestAndAdd(key T) bool {
+	h := t.hash(key)
+	present := t.test(h)
+	t.add(h)
+	return present
+}
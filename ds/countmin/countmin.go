@@ -0,0 +1,231 @@
+package countmin
+
+import (
+	"encoding/binary"
+	"errors"
+	"iter"
+	"math"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/hashing"
+)
+
+// # Count-Min Sketch - Data Structure
+
+// A Count-Min Sketch (Cormode and Muthukrishnan) estimates how many times each key occurred in a stream, in a fixed amount of memory whatever the number of distinct keys, where a map keeps a counter per key. It never underestimates a count and overestimates it by at most ε·N with probability 1-δ, N being the total of all counts.
+
+// The sketch is a table of d rows (depth) of w counters (width), each row with its own hash function.
+// - Add: add the count to the counter the key hashes to in every row.
+// - Estimate: the smallest of the d counters of the key. Every counter also holds the counts of the keys that collide with it, so the smallest one is the closest to the true count.
+
+// For an error ε and a failure probability δ, the sizes are w = ⌈e/ε⌉ and d = ⌈ln(1/δ)⌉.
+
+// ## Conservative update (Estan and Varghese):
+// Instead of adding the count to all d counters, raise each of them only up to the new estimate of the key, the smallest counter plus the count. Counters grow more slowly, so estimates are much closer to the true counts on skewed streams, at no extra cost. It keeps the guarantees, and conservative sketches can still be merged, but they cannot count down.
+
+// ## TopK:
+// The heavy hitters of a stream: a sketch that counts every key and a min heap of the k keys with the largest estimates. A key whose estimate goes past the smallest one in the heap takes its place.
+
+// ## Usages:
+// - Heavy hitters and trending items: top queries, hashtags, products, talkers in network traffic.
+// - Frequency estimation in databases and stream processors (approximate GROUP BY, join size estimation).
+// - Cache admission (TinyLFU), rate limiting and DDoS detection by key.
+
+var (
+	// ErrIncompatible is returned when merging sketches that differ in width, depth, seed or update rule.
+	ErrIncompatible = errors.New("countmin: incompatible sketches")
+	// ErrInvalidFormat is returned by UnmarshalBinary for data that is not a serialized sketch.
+	ErrInvalidFormat = errors.New("countmin: invalid serialized sketch")
+)
+
+// Sketch is a Count-Min Sketch.
+type Sketch struct {
+	width, depth uint64
+	seed         uint64
+	// counters holds the rows one after the other.
+	counters     []uint64
+	total        uint64
+	conservative bool
+}
+
+// New returns a Sketch of depth rows of width counters, both at least 1. Keys are hashed with the given seed,
+// and only sketches with the same seed can be merged.
+func New(width, depth int, seed uint64) *Sketch {
+	width, depth = max(width, 1), max(depth, 1)
+	return &Sketch{width: uint64(width), depth: uint64(depth), seed: seed, counters: make([]uint64, width*depth)}
+}
+
+// NewConservative is like New but the Sketch uses conservative updates.
+func NewConservative(width, depth int, seed uint64) *Sketch {
+	s := New(width, depth, seed)
+	s.conservative = true
+	return s
+}
+
+// EstimateParameters returns the width and depth for estimates within epsilon times the total count
+// with probability 1-delta. Both rates are clamped to (0, 1).
+func EstimateParameters(epsilon, delta float64) (width, depth int) {
+	epsilon, delta = clampRate(epsilon), clampRate(delta)
+	return int(math.Ceil(math.E / epsilon)), int(math.Ceil(math.Log(1 / delta)))
+}
+
+// Add - Adds count occurrences of a key.
+func (s *Sketch) Add(key []byte, count uint64) {
+	s.add(hashing.Sum64(key, s.seed), count)
+}
+
+// AddString - Adds count occurrences of a string key.
+func (s *Sketch) AddString(key string, count uint64) {
+	s.add(hashing.Sum64String(key, s.seed), count)
+}
+
+// Estimate - Returns the estimated number of occurrences of a key. It is never below the true count.
+func (s *Sketch) Estimate(key []byte) uint64 {
+	return s.estimate(hashing.Sum64(key, s.seed))
+}
+
+// EstimateString - Returns the estimated number of occurrences of a string key.
+func (s *Sketch) EstimateString(key string) uint64 {
+	return s.estimate(hashing.Sum64String(key, s.seed))
+}
+
+// Total - Returns the sum of all counts added.
+func (s *Sketch) Total() uint64 {
+	return s.total
+}
+
+// ErrorBound - Returns how much an estimate may exceed the true count, e/width times the total count.
+// It holds with probability Confidence.
+func (s *Sketch) ErrorBound() uint64 {
+	return uint64(math.Ceil(math.E / float64(s.width) * float64(s.total)))
+}
+
+// Confidence - Returns the probability that an estimate is within ErrorBound of the true count, 1 - e^-depth.
+func (s *Sketch) Confidence() float64 {
+	return 1 - math.Exp(-float64(s.depth))
+}
+
+// Width - Returns the number of counters per row.
+func (s *Sketch) Width() int {
+	return int(s.width)
+}
+
+// Depth - Returns the number of rows.
+func (s *Sketch) Depth() int {
+	return int(s.depth)
+}
+
+// IsConservative - Checks if the Sketch uses conservative updates.
+func (s *Sketch) IsConservative() bool {
+	return s.conservative
+}
+
+// Merge - Adds the counts of other to s, so s estimates the counts of both streams.
+// Both sketches must have the same width, depth and seed, and both use conservative updates or neither.
+func (s *Sketch) Merge(other *Sketch) error {
+	if s.width != other.width || s.depth != other.depth || s.seed != other.seed || s.conservative != other.conservative {
+		return ErrIncompatible
+	}
+	for i, c := range other.counters {
+		s.counters[i] += c
+	}
+	s.total += other.total
+	return nil
+}
+
+// Clone - Returns a copy of the Sketch.
+func (s *Sketch) Clone() *Sketch {
+	c := *s
+	c.counters = append([]uint64(nil), s.counters...)
+	return &c
+}
+
+// Clear - Resets every count to 0.
+func (s *Sketch) Clear() {
+	clear(s.counters)
+	s.total = 0
+}
+
+// MarshalBinary - Encodes the Sketch: width, depth, seed, total and a conservative flag (0 or 1),
+// then the counters row by row, all as little endian uint64.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 40+8*len(s.counters))
+	data = binary.LittleEndian.AppendUint64(data, s.width)
+	data = binary.LittleEndian.AppendUint64(data, s.depth)
+	data = binary.LittleEndian.AppendUint64(data, s.seed)
+	data = binary.LittleEndian.AppendUint64(data, s.total)
+	conservative := uint64(0)
+	if s.conservative {
+		conservative = 1
+	}
+	data = binary.LittleEndian.AppendUint64(data, conservative)
+	for _, c := range s.counters {
+		data = binary.LittleEndian.AppendUint64(data, c)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replaces the Sketch with a Sketch encoded by MarshalBinary.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	if len(data) < 40 {
+		return ErrInvalidFormat
+	}
+	width := binary.LittleEndian.Uint64(data)
+	depth := binary.LittleEndian.Uint64(data[8:])
+	seed := binary.LittleEndian.Uint64(data[16:])
+	total := binary.LittleEndian.Uint64(data[24:])
+	conservative := binary.LittleEndian.Uint64(data[32:])
+	data = data[40:]
+	if width == 0 || depth == 0 || conservative > 1 || width > uint64(len(data))/8/depth ||
+		uint64(len(data)) != 8*width*depth {
+		return ErrInvalidFormat
+	}
+	counters := make([]uint64, width*depth)
+	for i := range counters {
+		counters[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	*s = Sketch{width: width, depth: depth, seed: seed, counters: counters, total: total, conservative: conservative == 1}
+	return nil
+}
+
+func (s *Sketch) add(h, count uint64) {
+	s.total += count
+	if s.conservative {
+		estimate := s.estimate(h) + count
+		for loc := range s.locations(h) {
+			s.counters[loc] = max(s.counters[loc], estimate)
+		}
+		return
+	}
+	for loc := range s.locations(h) {
+		s.counters[loc] += count
+	}
+}
+
+func (s *Sketch) estimate(h uint64) uint64 {
+	estimate := uint64(math.MaxUint64)
+	for loc := range s.locations(h) {
+		estimate = min(estimate, s.counters[loc])
+	}
+	return estimate
+}
+
+// locations returns an iterator over the index of the counter of hash h in every row. The row hashes
+// are derived from h by double hashing: row i uses (h + i*step) mod width.
+func (s *Sketch) locations(h uint64) iter.Seq[uint64] {
+	step := hashing.Mix64(h) | 1
+	return func(yield func(uint64) bool) {
+		for i := range s.depth {
+			if !yield(i*s.width + (h+i*step)%s.width) {
+				return
+			}
+		}
+	}
+}
+
+// clampRate keeps a rate inside (0, 1).
+func clampRate(p float64) float64 {
+	if math.IsNaN(p) {
+		return 0.01
+	}
+	return min(max(p, 1e-9), 0.999)
+}
@@ -0,0 +1,199 @@
+package countmin
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// zipf returns a stream of n keys drawn from a Zipf distribution over 100000 keys, and the true count of each.
+func zipf(seed uint64, n int) ([]uint64, map[uint64]uint64) {
+	z := rand.NewZipf(rand.New(rand.NewPCG(seed, seed)), 1.1, 1, 100000)
+	stream := make([]uint64, n)
+	counts := make(map[uint64]uint64)
+	for i := range stream {
+		stream[i] = z.Uint64()
+		counts[stream[i]]++
+	}
+	return stream, counts
+}
+
+// key returns the bytes a key of a zipf stream is counted as.
+func key(k uint64) []byte {
+	return strconv.AppendUint(nil, k, 10)
+}
+
+func TestEstimateNeverBelow(t *testing.T) {
+	stream, counts := zipf(1, 50000)
+	for _, s := range []*Sketch{New(200, 4, 7), NewConservative(200, 4, 7)} {
+		for _, k := range stream {
+			s.Add(key(k), 1)
+		}
+		for k, count := range counts {
+			if got := s.Estimate(key(k)); got < count {
+				t.Fatalf("conservative=%v: Estimate(%d) = %d, below the true count %d", s.IsConservative(), k, got, count)
+			}
+		}
+		if s.Total() != uint64(len(stream)) {
+			t.Fatalf("Total() = %d, want %d", s.Total(), len(stream))
+		}
+		// A key that was never added is only estimated from the keys it collides with.
+		if got := s.EstimateString("absent"); got > s.ErrorBound() {
+			t.Fatalf("Estimate() of an absent key = %d, above the bound %d", got, s.ErrorBound())
+		}
+	}
+}
+
+func TestErrorBound(t *testing.T) {
+	const epsilon, delta = 0.001, 0.01
+	width, depth := EstimateParameters(epsilon, delta)
+	if width != 2719 || depth != 5 {
+		t.Fatalf("EstimateParameters(%v, %v) = %d, %d, want 2719, 5", epsilon, delta, width, depth)
+	}
+	for seed := range uint64(3) {
+		stream, counts := zipf(seed, 200000)
+		for _, s := range []*Sketch{New(width, depth, seed), NewConservative(width, depth, seed)} {
+			for _, k := range stream {
+				s.Add(key(k), 1)
+			}
+			bound := epsilon * float64(len(stream))
+			if got := s.ErrorBound(); float64(got) < bound || float64(got) > bound+1 {
+				t.Fatalf("ErrorBound() = %d, want %.0f", got, bound)
+			}
+			// Each estimate is within ε·N with probability 1-δ, so at most a share δ of the keys is further off.
+			off := 0
+			for k, count := range counts {
+				if float64(s.Estimate(key(k))-count) > bound {
+					off++
+				}
+			}
+			if share := float64(off) / float64(len(counts)); share > delta {
+				t.Fatalf("seed %d, conservative=%v: %d of %d estimates (%.3f) are more than %.0f off, want at most %v",
+					seed, s.IsConservative(), off, len(counts), share, bound, delta)
+			}
+		}
+	}
+}
+
+func TestConservativeNeverAbovePlain(t *testing.T) {
+	for seed := range uint64(5) {
+		stream, counts := zipf(seed, 30000)
+		plain, conservative := New(100, 3, seed), NewConservative(100, 3, seed)
+		r := rand.New(rand.NewPCG(seed, 0))
+		for _, k := range stream {
+			n := r.Uint64N(5) + 1
+			plain.Add(key(k), n)
+			conservative.Add(key(k), n)
+			counts[k] += n - 1
+		}
+		tighter := 0
+		for k, count := range counts {
+			p, c := plain.Estimate(key(k)), conservative.Estimate(key(k))
+			if c < count || c > p {
+				t.Fatalf("seed %d: Estimate(%d) = %d conservative and %d plain, true count %d", seed, k, c, p, count)
+			}
+			if c < p {
+				tighter++
+			}
+		}
+		if tighter == 0 {
+			t.Fatalf("seed %d: no conservative estimate is below the plain one", seed)
+		}
+		if plain.Total() != conservative.Total() {
+			t.Fatalf("Total() = %d plain and %d conservative", plain.Total(), conservative.Total())
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	stream, counts := zipf(3, 20000)
+	for _, newSketch := range []func(width, depth int, seed uint64) *Sketch{New, NewConservative} {
+		whole, left, right := newSketch(300, 4, 9), newSketch(300, 4, 9), newSketch(300, 4, 9)
+		for i, k := range stream {
+			whole.Add(key(k), 1)
+			if i%2 == 0 {
+				left.Add(key(k), 1)
+			} else {
+				right.Add(key(k), 1)
+			}
+		}
+		if err := left.Merge(right); err != nil {
+			t.Fatal(err)
+		}
+		if left.Total() != whole.Total() {
+			t.Fatalf("Total() = %d, want %d", left.Total(), whole.Total())
+		}
+		for k, count := range counts {
+			got := left.Estimate(key(k))
+			if got < count {
+				t.Fatalf("Estimate(%d) = %d after Merge(), below the true count %d", k, got, count)
+			}
+			// Plain sketches add up to the sketch of the whole stream. Conservative ones may be higher,
+			// but stay within the error bound.
+			if want := whole.Estimate(key(k)); !left.IsConservative() && got != want || got > count+left.ErrorBound() {
+				t.Fatalf("conservative=%v: Estimate(%d) = %d after Merge(), %d for the whole stream, true count %d",
+					left.IsConservative(), k, got, want, count)
+			}
+		}
+	}
+
+	s := New(100, 3, 1)
+	for name, other := range map[string]*Sketch{
+		"width":        New(101, 3, 1),
+		"depth":        New(100, 4, 1),
+		"seed":         New(100, 3, 2),
+		"conservative": NewConservative(100, 3, 1),
+	} {
+		other.AddString("a", 1)
+		if err := s.Merge(other); !errors.Is(err, ErrIncompatible) {
+			t.Fatalf("Merge() of a different %s = %v, want ErrIncompatible", name, err)
+		}
+		if err := other.Merge(s); !errors.Is(err, ErrIncompatible) {
+			t.Fatalf("Merge() into a different %s = %v, want ErrIncompatible", name, err)
+		}
+		if s.Total() != 0 {
+			t.Fatalf("a failed Merge() changed the Sketch")
+		}
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	for _, s := range []*Sketch{New(50, 3, 5), NewConservative(64, 1, 1<<63), New(1, 1, 0)} {
+		t.Run(fmt.Sprintf("%dx%d conservative=%v", s.Width(), s.Depth(), s.IsConservative()), func(t *testing.T) {
+			for i := range 500 {
+				s.AddString(strconv.Itoa(i%37), uint64(i))
+			}
+			data, err := s.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := &Sketch{}
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() = %v", err)
+			}
+			if decoded.Width() != s.Width() || decoded.Depth() != s.Depth() || decoded.IsConservative() != s.IsConservative() ||
+				decoded.Total() != s.Total() || !slices.Equal(decoded.counters, s.counters) {
+				t.Fatal("the decoded Sketch differs from the original")
+			}
+			// The seed is kept, so the decoded Sketch keeps counting like the original and can be merged with it.
+			decoded.AddString("new", 3)
+			s.AddString("new", 3)
+			if decoded.EstimateString("new") != s.EstimateString("new") || decoded.Merge(s) != nil {
+				t.Fatal("the decoded Sketch does not hash like the original")
+			}
+			for size := range len(data) {
+				if err := New(1, 1, 0).UnmarshalBinary(data[:size]); !errors.Is(err, ErrInvalidFormat) {
+					t.Fatalf("UnmarshalBinary() of the first %d bytes = %v, want ErrInvalidFormat", size, err)
+				}
+			}
+			bad := slices.Clone(data)
+			bad[32] = 2
+			if err := decoded.UnmarshalBinary(bad); !errors.Is(err, ErrInvalidFormat) {
+				t.Fatalf("UnmarshalBinary() with a conservative flag of 2 = %v, want ErrInvalidFormat", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package countmin
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/hashing"
+	"github.com/rama-kairi/ds-algo/ds/priorityqueue"
+)
+
+// Entry is a value tracked by a TopK with its estimated count. The true count is between
+// Count - Error and Count, with the probability given by the Confidence of the sketch.
+type Entry[T any] struct {
+	Value T
+	Count uint64
+	Error uint64
+}
+
+// Integer is the set of integer types NewIntegerTopK accepts.
+type Integer = hashing.Integer
+
+// TopK tracks the k values with the largest counts in a stream, each hashed with the seed of the Sketch
+// that counts them.
+type TopK[T comparable] struct {
+	k      int
+	sketch *Sketch
+	hash   func(value T, seed uint64) uint64
+	// heap holds the tracked values, the one with the smallest count first.
+	heap *priorityqueue.PriorityQueue[Entry[T]]
+	// items holds the heap handle of every tracked value.
+	items map[T]*priorityqueue.Item[Entry[T]]
+}
+
+// NewStringTopK returns a TopK of k string values, at least 1, counted by sketch as AddString counts them.
+func NewStringTopK[T ~string](k int, sketch *Sketch) *TopK[T] {
+	return newTopK(k, sketch, func(v T, seed uint64) uint64 { return hashing.Sum64String(string(v), seed) })
+}
+
+// NewIntegerTopK returns a TopK of k integer values, at least 1, counted by sketch. Each value is hashed by
+// its unsigned varint (negative values sign extended to 64 bits), as Add hashes bytes.
+func NewIntegerTopK[T Integer](k int, sketch *Sketch) *TopK[T] {
+	return newTopK(k, sketch, hashing.SumInteger[T])
+}
+
+// NewTopKFunc returns a TopK of k values, at least 1, counted by sketch, which counts the bytes key returns
+// for each value. Values that are equal must give the same bytes.
+func NewTopKFunc[T comparable](k int, sketch *Sketch, key func(T) []byte) *TopK[T] {
+	return newTopK(k, sketch, func(v T, seed uint64) uint64 { return hashing.Sum64(key(v), seed) })
+}
+
+func newTopK[T comparable](k int, sketch *Sketch, hash func(T, uint64) uint64) *TopK[T] {
+	return &TopK[T]{
+		k:      max(k, 1),
+		sketch: sketch,
+		hash:   hash,
+		heap:   priorityqueue.New(func(a, b Entry[T]) bool { return a.Count < b.Count }),
+		items:  make(map[T]*priorityqueue.Item[Entry[T]]),
+	}
+}
+
+// Add - Adds an occurrence of a value.
+func (t *TopK[T]) Add(value T) {
+	t.AddN(value, 1)
+}
+
+// AddN - Adds count occurrences of a value.
+func (t *TopK[T]) AddN(value T, count uint64) {
+	h := t.hash(value, t.sketch.seed)
+	t.sketch.add(h, count)
+	t.offer(value, t.sketch.estimate(h))
+}
+
+// Items - Returns the tracked values, the most frequent first, with their current estimates.
+func (t *TopK[T]) Items() []Entry[T] {
+	bound := t.sketch.ErrorBound()
+	entries := make([]Entry[T], 0, len(t.items))
+	for value := range t.items {
+		count := t.sketch.estimate(t.hash(value, t.sketch.seed))
+		entries = append(entries, Entry[T]{Value: value, Count: count, Error: min(bound, count)})
+	}
+	slices.SortFunc(entries, func(a, b Entry[T]) int { return cmp.Compare(b.Count, a.Count) })
+	return entries
+}
+
+// Estimate - Returns the estimated count of any value, tracked or not.
+func (t *TopK[T]) Estimate(value T) uint64 {
+	return t.sketch.estimate(t.hash(value, t.sketch.seed))
+}
+
+// Len - Returns the number of values tracked, at most k.
+func (t *TopK[T]) Len() int {
+	return len(t.items)
+}
+
+// Sketch - Returns the Sketch that counts the values.
+func (t *TopK[T]) Sketch() *Sketch {
+	return t.sketch
+}
+
+// Merge - Adds the counts of other to t and keeps the k most frequent of the values tracked by either.
+// Both sketches must have the same width, depth, seed and update rule, and both TopKs must hash their values
+// the same way.
+func (t *TopK[T]) Merge(other *TopK[T]) error {
+	if err := t.sketch.Merge(other.sketch); err != nil {
+		return err
+	}
+	candidates := make([]T, 0, len(t.items)+len(other.items))
+	for value := range t.items {
+		candidates = append(candidates, value)
+	}
+	for value := range other.items {
+		if _, ok := t.items[value]; !ok {
+			candidates = append(candidates, value)
+		}
+	}
+	t.heap.Clear()
+	clear(t.items)
+	for _, value := range candidates {
+		t.offer(value, t.sketch.estimate(t.hash(value, t.sketch.seed)))
+	}
+	return nil
+}
+
+// Clear - Resets every count and forgets the tracked values.
+func (t *TopK[T]) Clear() {
+	t.sketch.Clear()
+	t.heap.Clear()
+	clear(t.items)
+}
+
+// offer updates the count of a tracked value, or tracks it if it has room or beats the smallest tracked count.
+func (t *TopK[T]) offer(value T, count uint64) {
+	if item, ok := t.items[value]; ok {
+		t.heap.Update(item, Entry[T]{Value: value, Count: count})
+		return
+	}
+	if t.heap.Len() == t.k {
+		smallest, _ := t.heap.Peek()
+		if count <= smallest.Count {
+			return
+		}
+		t.heap.Pop()
+		delete(t.items, smallest.Value)
+	}
+	t.items[value] = t.heap.Push(Entry[T]{Value: value, Count: count})
+}
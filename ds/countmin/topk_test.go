@@ -0,0 +1,125 @@
+package countmin
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// heaviest returns the k keys with the largest counts, the most frequent first.
+func heaviest(counts map[uint64]uint64, k int) []uint64 {
+	keys := slices.SortedFunc(maps.Keys(counts), func(a, b uint64) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+	return keys[:k]
+}
+
+// checkTopK checks that t reports the heaviest k keys of counts, most frequent first, each with a true count
+// between Count - Error and Count.
+func checkTopK(t *testing.T, topK *TopK[uint64], counts map[uint64]uint64, k int) {
+	t.Helper()
+	want := heaviest(counts, k)
+	items := topK.Items()
+	got := make([]uint64, len(items))
+	for i, e := range items {
+		got[i] = e.Value
+		if i > 0 && e.Count > items[i-1].Count {
+			t.Fatalf("Items() not sorted by count: %v", items)
+		}
+		if count := counts[e.Value]; count > e.Count || count < e.Count-e.Error || e.Error > topK.Sketch().ErrorBound() {
+			t.Fatalf("Items() = %+v for a true count of %d", e, count)
+		}
+		if est := topK.Estimate(e.Value); est != e.Count {
+			t.Fatalf("Estimate(%d) = %d, Items() says %d", e.Value, est, e.Count)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Items() = %v, want %v", got, want)
+	}
+}
+
+func TestTopKZipf(t *testing.T) {
+	const k = 10
+	width, depth := EstimateParameters(0.0005, 0.001)
+	for seed := range uint64(3) {
+		stream, counts := zipf(seed, 200000)
+		for _, sketch := range []*Sketch{New(width, depth, seed), NewConservative(width, depth, seed)} {
+			topK := NewIntegerTopK[uint64](k, sketch)
+			for _, v := range stream {
+				topK.Add(v)
+				if topK.Len() > k {
+					t.Fatalf("Len() = %d, want at most %d", topK.Len(), k)
+				}
+			}
+			checkTopK(t, topK, counts, k)
+		}
+	}
+}
+
+func TestTopKMerge(t *testing.T) {
+	const k, workers = 5, 4
+	width, depth := EstimateParameters(0.0005, 0.001)
+	stream, counts := zipf(7, 100000)
+	shards := make([]*TopK[uint64], workers)
+	for i := range shards {
+		shards[i] = NewIntegerTopK[uint64](k, New(width, depth, 42))
+	}
+	// Each key goes to two of the workers, so every worker only sees part of its count.
+	for i, v := range stream {
+		shards[(int(v)+i%2)%workers].Add(v)
+	}
+	for _, shard := range shards[1:] {
+		if err := shards[0].Merge(shard); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkTopK(t, shards[0], counts, k)
+
+	other := NewIntegerTopK[uint64](k, New(width, depth, 43))
+	if err := shards[0].Merge(other); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Merge() with another seed = %v, want ErrIncompatible", err)
+	}
+}
+
+type word string
+
+func TestTopKKeys(t *testing.T) {
+	words := NewStringTopK[word](2, New(100, 3, 1))
+	points := NewTopKFunc(2, New(100, 3, 1), func(p [2]int32) []byte {
+		return binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(nil, uint32(p[0])), uint32(p[1]))
+	})
+	for i := range 10 {
+		words.AddN(word(strconv.Itoa(i%3)), uint64(i))
+		points.Add([2]int32{int32(i % 3), -1})
+	}
+	// 0+3+6+9 = 18, 1+4+7 = 12, 2+5+8 = 15.
+	if items := words.Items(); len(items) != 2 || items[0].Value != "0" || items[1].Value != "2" || items[0].Count != 18 {
+		t.Fatalf("Items() = %+v, want 0 counted 18 then 2", items)
+	}
+	if items := points.Items(); len(items) != 2 || items[0].Value != [2]int32{0, -1} || items[0].Count != 4 {
+		t.Fatalf("Items() = %+v, want {0, -1} counted 4 first", items)
+	}
+	// Values are counted by the sketch as AddString and Add count them.
+	if got := words.Sketch().EstimateString("1"); got != words.Estimate("1") || got != 12 {
+		t.Fatalf("EstimateString(\"1\") = %d, Estimate(\"1\") = %d, want 12", got, words.Estimate("1"))
+	}
+	if got := points.Sketch().Estimate([]byte{2, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}); got != 3 {
+		t.Fatalf("Estimate() of the bytes of {2, -1} = %d, want 3", got)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { words.Add("0") }); allocs != 0 {
+		t.Fatalf("Add() of a tracked string allocates %v times, want 0", allocs)
+	}
+
+	if k := NewIntegerTopK[int](0, New(10, 1, 0)); k.k != 1 {
+		t.Fatalf("NewIntegerTopK(0) tracks %d values, want 1", k.k)
+	}
+	words.Clear()
+	if words.Len() != 0 || words.Sketch().Total() != 0 || len(words.Items()) != 0 {
+		t.Fatal("Clear() left values or counts")
+	}
+}
@@ -0,0 +1,48 @@
+package hll
+
+import "github.com/rama-kairi/ds-algo/ds/internal/hashing"
+
+// Integer is the set of integer types NewIntegerCounter accepts.
+type Integer = hashing.Integer
+
+// Counter is a Sketch for values of type T, each hashed to the 64-bit hash the Sketch counts.
+// Its Add has the same shape as the Add of a set, so it can replace a set that is only used to count.
+type Counter[T any] struct {
+	*Sketch
+	hash func(T) uint64
+}
+
+// NewStringCounter returns an empty Counter of precision p for string values, hashed as AddString hashes them.
+func NewStringCounter[T ~string](p uint8) *Counter[T] {
+	return &Counter[T]{Sketch: New(p), hash: func(v T) uint64 { return hashing.Sum64String(string(v), 0) }}
+}
+
+// NewIntegerCounter returns an empty Counter of precision p for integer values, hashing the unsigned varint
+// of each value (negative values sign extended to 64 bits) as Add hashes bytes.
+func NewIntegerCounter[T Integer](p uint8) *Counter[T] {
+	return &Counter[T]{Sketch: New(p), hash: func(v T) uint64 { return hashing.SumInteger(v, 0) }}
+}
+
+// NewCounterFunc returns an empty Counter of precision p, hashing the bytes key returns for each value.
+// Values that are equal must give the same bytes.
+func NewCounterFunc[T any](p uint8, key func(T) []byte) *Counter[T] {
+	return &Counter[T]{Sketch: New(p), hash: func(v T) uint64 { return hashing.Sum64(key(v), 0) }}
+}
+
+// FromSet adds the values of a set, or of anything else with a ForEach such as the sets, bitsets and bitmaps
+// of this module, to c and returns c.
+func FromSet[T any](c *Counter[T], values interface{ ForEach(func(T)) }) *Counter[T] {
+	values.ForEach(c.Add)
+	return c
+}
+
+// Add - Adds a value to the Counter.
+func (c *Counter[T]) Add(value T) {
+	c.AddHash(c.hash(value))
+}
+
+// Merge - Adds every value counted by other to c. Both counters must have the same precision and hash their
+// values the same way.
+func (c *Counter[T]) Merge(other *Counter[T]) error {
+	return c.Sketch.Merge(other.Sketch)
+}
@@ -0,0 +1,61 @@
+package hll
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/set"
+)
+
+type visitor string
+
+func TestCounter(t *testing.T) {
+	names := NewStringCounter[visitor](DefaultPrecision)
+	ids := NewIntegerCounter[int64](DefaultPrecision)
+	pairs := NewCounterFunc(DefaultPrecision, func(p [2]byte) []byte { return p[:] })
+	for i := range 3000 {
+		names.Add(visitor(fmt.Sprint("user-", i%1000)))
+		ids.Add(int64(i%1000 - 500))
+		pairs.Add([2]byte{byte(i % 10), byte(i % 100 / 10)})
+	}
+	// Small cardinalities are counted exactly in the sparse representation.
+	if names.Count() != 1000 || ids.Count() != 1000 || pairs.Count() != 100 {
+		t.Fatalf("Count() = %d, %d and %d, want 1000, 1000 and 100", names.Count(), ids.Count(), pairs.Count())
+	}
+
+	// A Counter hashes its values as the Sketch hashes strings or bytes.
+	s := New(DefaultPrecision)
+	s.AddString("a")
+	c := NewStringCounter[string](DefaultPrecision)
+	c.Add("a")
+	if !sameRegisters(s, c.Sketch) {
+		t.Fatal("NewStringCounter() hashes strings differently from AddString")
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		names.Add("user-1")
+		ids.Add(-1)
+	}); allocs != 0 {
+		t.Fatalf("Add() of strings and integers allocates %v times, want 0", allocs)
+	}
+}
+
+func TestFromSet(t *testing.T) {
+	values := set.New[int]()
+	for i := range 500 {
+		values.Add(i * 7)
+	}
+	c := NewIntegerCounter[int](12)
+	if got := FromSet(c, values); got != c || c.Count() != 500 {
+		t.Fatalf("FromSet() counted %d values, want 500", c.Count())
+	}
+
+	// Merging the counters of two shards counts the values of both once.
+	other := NewIntegerCounter[int](12)
+	for i := range 500 {
+		other.Add(i * 14)
+	}
+	if err := c.Merge(other); err != nil || c.Count() != 750 {
+		t.Fatalf("Merge() = %v, Count() = %d, want 750", err, c.Count())
+	}
+}
@@ -0,0 +1,75 @@
+package hll
+
+import "encoding/binary"
+
+// Serialized sketches start with a version byte, the precision and the representation.
+// A sparse sketch is then followed by its number of entries and the entries, as little endian uint32;
+// a dense sketch by its 2^p registers, one byte each.
+const (
+	encodingVersion = 1
+	modeSparse      = 0
+	modeDense       = 1
+)
+
+// MarshalBinary - Encodes the Sketch, keeping its representation.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	if s.registers != nil {
+		data := make([]byte, 0, 3+len(s.registers))
+		data = append(data, encodingVersion, s.p, modeDense)
+		return append(data, s.registers...), nil
+	}
+	data := make([]byte, 0, 7+4*len(s.sparse))
+	data = append(data, encodingVersion, s.p, modeSparse)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(s.sparse)))
+	for _, e := range s.sparse {
+		data = binary.LittleEndian.AppendUint32(data, e)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replaces the Sketch with a Sketch encoded by MarshalBinary.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 || data[0] != encodingVersion {
+		return ErrInvalidFormat
+	}
+	p, mode := data[1], data[2]
+	if p < MinPrecision || p > MaxPrecision {
+		return ErrInvalidFormat
+	}
+	data = data[3:]
+	switch mode {
+	case modeDense:
+		if len(data) != 1<<p {
+			return ErrInvalidFormat
+		}
+		for _, rho := range data {
+			if int(rho) > 64-int(p)+1 {
+				return ErrInvalidFormat
+			}
+		}
+		s.p, s.sparse, s.registers = p, nil, append([]uint8(nil), data...)
+	case modeSparse:
+		if len(data) < 4 {
+			return ErrInvalidFormat
+		}
+		n := binary.LittleEndian.Uint32(data)
+		data = data[4:]
+		if uint64(len(data)) != 4*uint64(n) {
+			return ErrInvalidFormat
+		}
+		sparse := make([]uint32, n)
+		for i := range sparse {
+			e := binary.LittleEndian.Uint32(data[4*i:])
+			rho := e & (1<<rhoBits - 1)
+			if e>>rhoBits >= 1<<sparsePrecision || rho == 0 || rho > 64-sparsePrecision+1 ||
+				(i > 0 && compareIndex(e, sparse[i-1]) <= 0) {
+				return ErrInvalidFormat
+			}
+			sparse[i] = e
+		}
+		s.p, s.sparse, s.registers = p, sparse, nil
+	default:
+		return ErrInvalidFormat
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package hll
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, 500, 100000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := New(12)
+			for i := range n {
+				s.AddString(fmt.Sprint(i))
+			}
+			data, err := s.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := &Sketch{}
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() = %v", err)
+			}
+			if decoded.IsSparse() != s.IsSparse() || decoded.Precision() != s.Precision() || decoded.Count() != s.Count() {
+				t.Fatalf("decoded sparse=%v p=%d Count()=%d, want sparse=%v p=%d Count()=%d",
+					decoded.IsSparse(), decoded.Precision(), decoded.Count(), s.IsSparse(), s.Precision(), s.Count())
+			}
+			if again, _ := decoded.MarshalBinary(); !slices.Equal(again, data) {
+				t.Fatal("encoding the decoded Sketch gives other bytes")
+			}
+			// The decoded Sketch keeps counting like the original, across the switch to dense too.
+			for i := n; i < n+2000; i++ {
+				s.AddString(fmt.Sprint(i))
+				decoded.AddString(fmt.Sprint(i))
+			}
+			want, _ := s.MarshalBinary()
+			if again, _ := decoded.MarshalBinary(); !slices.Equal(again, want) {
+				t.Fatal("adding the same values to the decoded Sketch and the original gives other bytes")
+			}
+			for size := range len(data) {
+				if err := New(12).UnmarshalBinary(data[:size]); !errors.Is(err, ErrInvalidFormat) {
+					t.Fatalf("UnmarshalBinary() of the first %d bytes = %v, want ErrInvalidFormat", size, err)
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	dense := append([]byte{encodingVersion, MinPrecision, modeDense}, make([]byte, 1<<MinPrecision)...)
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"unknown version", []byte{2, 12, modeSparse, 0, 0, 0, 0}},
+		{"precision too low", []byte{encodingVersion, MinPrecision - 1, modeSparse, 0, 0, 0, 0}},
+		{"precision too high", []byte{encodingVersion, MaxPrecision + 1, modeSparse, 0, 0, 0, 0}},
+		{"unknown mode", []byte{encodingVersion, 12, 2, 0, 0, 0, 0}},
+		{"dense rank too large", append(dense[:len(dense)-1:len(dense)-1], 64-MinPrecision+2)},
+		{"sparse rank 0", []byte{encodingVersion, 12, modeSparse, 1, 0, 0, 0, 0, 1, 0, 0}},
+		{"sparse not sorted", []byte{encodingVersion, 12, modeSparse, 2, 0, 0, 0, 0x41, 0, 0, 0, 0x01, 0, 0, 0}},
+		{"sparse repeated index", []byte{encodingVersion, 12, modeSparse, 2, 0, 0, 0, 0x41, 0, 0, 0, 0x42, 0, 0, 0}},
+	}
+	if err := New(12).UnmarshalBinary(dense); err != nil {
+		t.Fatalf("UnmarshalBinary() of empty dense registers = %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(12)
+			s.AddString("a")
+			if err := s.UnmarshalBinary(tt.data); !errors.Is(err, ErrInvalidFormat) {
+				t.Fatalf("UnmarshalBinary() = %v, want ErrInvalidFormat", err)
+			}
+			if s.Count() != 1 || s.Precision() != 12 {
+				t.Fatal("a failed UnmarshalBinary() changed the Sketch")
+			}
+		})
+	}
+}
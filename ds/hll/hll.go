@@ -0,0 +1,279 @@
+package hll
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/hashing"
+)
+
+// # HyperLogLog - Data Structure
+
+// HyperLogLog estimates the number of distinct values in a stream (its cardinality) in a few kilobytes, whatever the number of values, where an exact set keeps every value in memory. The standard error is about 1.04 / sqrt(m) for m registers, 1.6% with the default 4096.
+
+// Every value is hashed to 64 bits. The first p bits pick one of m = 2^p registers and the register keeps the longest run of leading zeros (plus one) seen in the rest of the hash. A run of r zeros shows up about once every 2^r distinct values, so the registers together tell how many distinct values went by. Repeated values hash the same way, so they never change the estimate.
+
+// ## HyperLogLog++ (Heule, Nunkesser and Hall):
+// - 64-bit hashes, so the estimate does not saturate at a few billion values.
+// - Sparse representation: while few values were added, only the registers that were set are kept, at a higher precision p' = 25. The sketch turns dense once the sparse list would take more memory than the registers.
+// - Small cardinalities: in the sparse representation the estimate is linear counting over the 2^25 sparse registers, which is nearly exact.
+
+// Instead of the empirical bias correction tables of the paper, the dense estimate is the improved estimator of Otmar Ertl ("New cardinality estimation algorithms for HyperLogLog sketches", 2017), which is unbiased over the whole range of cardinalities without tables.
+
+// ## Operations:
+// - Add: O(1) in the dense representation, O(log n) search plus an insertion in the sparse one.
+// - Count: O(m).
+// - Merge: the register-wise maximum of two sketches, the sketch of the union of both streams. So shards can be counted separately and merged.
+
+// ## Usages:
+// - Distinct visitors, IPs, search queries or IDs in analytics (Redis PFCOUNT, BigQuery, Druid, Presto).
+// - Join and query planning in databases, which need the number of distinct keys of a column.
+
+const (
+	// MinPrecision and MaxPrecision bound the precision of a sketch.
+	MinPrecision = 4
+	MaxPrecision = 18
+	// DefaultPrecision is 4096 registers, a standard error of about 1.6%.
+	DefaultPrecision = 14
+
+	// sparsePrecision is the precision p' of the sparse representation.
+	sparsePrecision = 25
+	// rhoBits is the number of low bits of a sparse entry holding its rank.
+	rhoBits = 6
+)
+
+var (
+	// ErrPrecisionMismatch is returned when merging sketches of different precisions.
+	ErrPrecisionMismatch = errors.New("hll: sketches have different precisions")
+	// ErrInvalidFormat is returned by UnmarshalBinary for data that is not a serialized sketch.
+	ErrInvalidFormat = errors.New("hll: invalid serialized sketch")
+)
+
+// Sketch is a HyperLogLog++ sketch.
+type Sketch struct {
+	p uint8
+	// sparse holds, sorted by index, one entry index<<rhoBits | rank per sparse register that is set.
+	// It is nil once the sketch is dense.
+	sparse []uint32
+	// registers holds the rank of each of the 2^p registers once the sketch is dense, nil before.
+	registers []uint8
+}
+
+// New returns an empty Sketch with 2^p registers, p clamped to [MinPrecision, MaxPrecision].
+func New(p uint8) *Sketch {
+	return &Sketch{p: min(max(p, MinPrecision), MaxPrecision), sparse: []uint32{}}
+}
+
+// Add - Adds a value to the Sketch.
+func (s *Sketch) Add(data []byte) {
+	s.AddHash(hashing.Sum64(data, 0))
+}
+
+// AddString - Adds a string value to the Sketch.
+func (s *Sketch) AddString(value string) {
+	s.AddHash(hashing.Sum64String(value, 0))
+}
+
+// AddHash - Adds a value by its 64-bit hash, for values hashed elsewhere. The hash must be uniformly distributed,
+// and sketches that are merged must be fed the same hash function.
+func (s *Sketch) AddHash(x uint64) {
+	if s.registers != nil {
+		idx, rho := denseEntry(x, s.p)
+		s.registers[idx] = max(s.registers[idx], rho)
+		return
+	}
+	e := sparseEntry(x)
+	i, found := slices.BinarySearchFunc(s.sparse, e, compareIndex)
+	switch {
+	case found:
+		s.sparse[i] = max(s.sparse[i], e)
+	default:
+		s.sparse = slices.Insert(s.sparse, i, e)
+		if len(s.sparse) > s.sparseLimit() {
+			s.toDense()
+		}
+	}
+}
+
+// Count - Returns the estimated number of distinct values added.
+func (s *Sketch) Count() uint64 {
+	if s.registers == nil {
+		m := float64(uint64(1) << sparsePrecision)
+		return uint64(math.Round(m * math.Log(m/(m-float64(len(s.sparse))))))
+	}
+	return uint64(math.Round(ertl(s.registers, s.p)))
+}
+
+// Precision - Returns the precision p of the Sketch, which has 2^p registers.
+func (s *Sketch) Precision() uint8 {
+	return s.p
+}
+
+// IsSparse - Checks if the Sketch still uses the sparse representation.
+func (s *Sketch) IsSparse() bool {
+	return s.registers == nil
+}
+
+// Merge - Adds every value counted by other to s, so s estimates the distinct values of both.
+// Both sketches must have the same precision.
+func (s *Sketch) Merge(other *Sketch) error {
+	if s.p != other.p {
+		return ErrPrecisionMismatch
+	}
+	if s.registers == nil && other.registers == nil {
+		s.sparse = mergeSparse(s.sparse, other.sparse)
+		if len(s.sparse) > s.sparseLimit() {
+			s.toDense()
+		}
+		return nil
+	}
+	if s.registers == nil {
+		s.toDense()
+	}
+	if other.registers == nil {
+		for _, e := range other.sparse {
+			idx, rho := denseFromSparse(e, s.p)
+			s.registers[idx] = max(s.registers[idx], rho)
+		}
+		return nil
+	}
+	for i, rho := range other.registers {
+		s.registers[i] = max(s.registers[i], rho)
+	}
+	return nil
+}
+
+// Clone - Returns a copy of the Sketch.
+func (s *Sketch) Clone() *Sketch {
+	c := &Sketch{p: s.p}
+	if s.registers != nil {
+		c.registers = slices.Clone(s.registers)
+	} else {
+		c.sparse = append([]uint32{}, s.sparse...)
+	}
+	return c
+}
+
+// Clear - Removes all values from the Sketch, which goes back to the sparse representation.
+func (s *Sketch) Clear() {
+	s.sparse = []uint32{}
+	s.registers = nil
+}
+
+// sparseLimit returns the number of sparse entries past which the registers take less memory.
+func (s *Sketch) sparseLimit() int {
+	return (1 << s.p) / 4
+}
+
+// toDense moves the sparse entries into registers.
+func (s *Sketch) toDense() {
+	s.registers = make([]uint8, 1<<s.p)
+	for _, e := range s.sparse {
+		idx, rho := denseFromSparse(e, s.p)
+		s.registers[idx] = max(s.registers[idx], rho)
+	}
+	s.sparse = nil
+}
+
+// denseEntry returns the register index of hash x at precision p and the rank of the remaining bits:
+// one more than their number of leading zeros.
+func denseEntry(x uint64, p uint8) (uint32, uint8) {
+	rho := bits.LeadingZeros64(x<<p|1<<(p-1)) + 1
+	return uint32(x >> (64 - p)), uint8(rho)
+}
+
+// sparseEntry returns the sparse entry of hash x, its index and rank at precision sparsePrecision.
+func sparseEntry(x uint64) uint32 {
+	idx, rho := denseEntry(x, sparsePrecision)
+	return idx<<rhoBits | uint32(rho)
+}
+
+// denseFromSparse returns the register index and rank at precision p of a sparse entry. The bits of the
+// sparse index past the first p are the first bits of the dense rank.
+func denseFromSparse(e uint32, p uint8) (uint32, uint8) {
+	idx := e >> rhoBits
+	shift := sparsePrecision - p
+	if rest := idx & (1<<shift - 1); rest != 0 {
+		return idx >> shift, shift - uint8(bits.Len32(rest)) + 1
+	}
+	return idx >> shift, uint8(e&(1<<rhoBits-1)) + shift
+}
+
+// compareIndex orders sparse entries by their index only.
+func compareIndex(a, b uint32) int {
+	return int(a>>rhoBits) - int(b>>rhoBits)
+}
+
+// mergeSparse returns the sorted sparse entries of a and b, keeping the highest rank of shared indexes.
+func mergeSparse(a, b []uint32) []uint32 {
+	merged := make([]uint32, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := compareIndex(a[i], b[j]); {
+		case c < 0:
+			merged = append(merged, a[i])
+			i++
+		case c > 0:
+			merged = append(merged, b[j])
+			j++
+		default:
+			merged = append(merged, max(a[i], b[j]))
+			i++
+			j++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
+
+// ertl returns Ertl's improved raw estimate for the registers of a dense sketch of precision p.
+func ertl(registers []uint8, p uint8) float64 {
+	q := 64 - int(p)
+	counts := make([]int, q+2)
+	for _, rho := range registers {
+		counts[rho]++
+	}
+	m := float64(len(registers))
+	if counts[0] == len(registers) {
+		return 0
+	}
+	z := m * tau(1-float64(counts[q+1])/m)
+	for k := q; k >= 1; k-- {
+		z = 0.5 * (z + float64(counts[k]))
+	}
+	z += m * sigma(float64(counts[0])/m)
+	return m * m / (2 * math.Ln2 * z)
+}
+
+// sigma is the series correcting the estimate for registers that are still 0.
+func sigma(x float64) float64 {
+	y, z := 1.0, x
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+		if z == prev {
+			return z
+		}
+	}
+}
+
+// tau is the series correcting the estimate for registers that reached the largest rank.
+func tau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y, z := 1.0, 1-x
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if z == prev {
+			return z / 3
+		}
+	}
+}
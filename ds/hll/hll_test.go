@@ -0,0 +1,198 @@
+package hll
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// stdError returns the standard error of a dense Sketch of precision p, 1.04/sqrt(2^p).
+func stdError(p uint8) float64 {
+	return 1.04 / math.Sqrt(float64(uint64(1)<<p))
+}
+
+// relativeError returns how far the estimate of s is from n, relative to n.
+func relativeError(s *Sketch, n int) float64 {
+	return math.Abs(float64(s.Count())-float64(n)) / float64(n)
+}
+
+func TestCountError(t *testing.T) {
+	const runs = 10
+	for _, p := range []uint8{10, 14} {
+		for _, n := range []int{100, 1000, 10000, 100000, 1000000} {
+			t.Run(fmt.Sprintf("p=%d/n=%d", p, n), func(t *testing.T) {
+				sigma := stdError(p)
+				squares := 0.0
+				for seed := range uint64(runs) {
+					r := rand.New(rand.NewPCG(seed, uint64(n)))
+					s := New(p)
+					for range n {
+						s.AddHash(r.Uint64())
+					}
+					e := relativeError(s, n)
+					if e > 4*sigma {
+						t.Fatalf("seed %d: Count() = %d, %.2f%% off, want within %.2f%%", seed, s.Count(), 100*e, 400*sigma)
+					}
+					squares += e * e
+				}
+				if rms := math.Sqrt(squares / runs); rms > 1.5*sigma {
+					t.Fatalf("root mean square error %.2f%%, want about %.2f%%", 100*rms, 100*sigma)
+				}
+			})
+		}
+	}
+}
+
+func TestAddDuplicates(t *testing.T) {
+	s := New(DefaultPrecision)
+	for range 10 {
+		for i := range 5000 {
+			s.AddString(fmt.Sprint("user-", i))
+		}
+	}
+	if e := relativeError(s, 5000); e > 4*stdError(DefaultPrecision) {
+		t.Fatalf("Count() = %d after adding 5000 values 10 times, want about 5000", s.Count())
+	}
+}
+
+func TestSparseToDense(t *testing.T) {
+	for _, p := range []uint8{MinPrecision, 10, DefaultPrecision, MaxPrecision} {
+		t.Run(fmt.Sprint("p=", p), func(t *testing.T) {
+			r := rand.New(rand.NewPCG(uint64(p), 1))
+			s := New(p)
+			n := 0
+			for s.IsSparse() {
+				// The sparse estimate is nearly exact.
+				if n > 0 && n%100 == 0 && relativeError(s, n) > 0.01 {
+					t.Fatalf("sparse Count() = %d for %d values", s.Count(), n)
+				}
+				before := s.Count()
+				s.AddHash(r.Uint64())
+				n++
+				if !s.IsSparse() {
+					if n < s.sparseLimit() {
+						t.Fatalf("turned dense after %d values, before the limit of %d", n, s.sparseLimit())
+					}
+					sigma := stdError(p)
+					if after := s.Count(); math.Abs(float64(after)-float64(before)) > 4*sigma*float64(n) {
+						t.Fatalf("Count() went from %d to %d when turning dense after %d values", before, after, n)
+					}
+				}
+			}
+			if len(s.registers) != 1<<p || s.sparse != nil {
+				t.Fatalf("dense Sketch has %d registers and %d sparse entries", len(s.registers), len(s.sparse))
+			}
+		})
+	}
+}
+
+// sameRegisters reports whether a and b hold the same registers once both are dense.
+func sameRegisters(a, b *Sketch) bool {
+	a, b = a.Clone(), b.Clone()
+	if a.registers == nil {
+		a.toDense()
+	}
+	if b.registers == nil {
+		b.toDense()
+	}
+	return slices.Equal(a.registers, b.registers)
+}
+
+func TestMerge(t *testing.T) {
+	const p = 12
+	tests := []struct {
+		name        string
+		a, b        [2]int
+		sparseUnion bool
+	}{
+		{"disjoint sparse", [2]int{0, 200}, [2]int{200, 400}, true},
+		{"overlapping sparse", [2]int{0, 300}, [2]int{100, 400}, true},
+		{"sparse turning dense", [2]int{0, 800}, [2]int{800, 1600}, false},
+		{"sparse into dense", [2]int{0, 100}, [2]int{50, 50000}, false},
+		{"dense into sparse", [2]int{0, 50000}, [2]int{49000, 49500}, false},
+		{"disjoint dense", [2]int{0, 50000}, [2]int{50000, 100000}, false},
+		{"overlapping dense", [2]int{0, 60000}, [2]int{40000, 100000}, false},
+		{"equal", [2]int{0, 30000}, [2]int{0, 30000}, false},
+		{"empty", [2]int{0, 0}, [2]int{0, 0}, true},
+	}
+	fill := func(s *Sketch, r [2]int) *Sketch {
+		for i := r[0]; i < r[1]; i++ {
+			s.AddString(fmt.Sprint(i))
+		}
+		return s
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := fill(New(p), tt.a), fill(New(p), tt.b)
+			lo, hi := min(tt.a[0], tt.b[0]), max(tt.a[1], tt.b[1])
+			union := fill(fill(New(p), tt.a), tt.b)
+			bBefore, _ := b.MarshalBinary()
+
+			if err := a.Merge(b); err != nil {
+				t.Fatal(err)
+			}
+			if a.IsSparse() != tt.sparseUnion || union.IsSparse() != tt.sparseUnion {
+				t.Fatalf("IsSparse() = %v after Merge() and %v after adding, want %v", a.IsSparse(), union.IsSparse(), tt.sparseUnion)
+			}
+			// The merged registers are those of a Sketch fed both streams, so the estimate is the same.
+			if !sameRegisters(a, union) || a.Count() != union.Count() {
+				t.Fatalf("Merge() = %d, want the %d of a Sketch fed both streams", a.Count(), union.Count())
+			}
+			if n := hi - lo; n > 0 && relativeError(a, n) > 4*stdError(p) {
+				t.Fatalf("Count() = %d, want about %d", a.Count(), n)
+			}
+			if bAfter, _ := b.MarshalBinary(); !slices.Equal(bAfter, bBefore) {
+				t.Fatal("Merge() changed other")
+			}
+		})
+	}
+
+	// Hashes with the same sparse index keep the highest rank, whichever sketch holds it. The index bits past
+	// the first p are 0, so the rank also decides the dense register.
+	low, high := uint64(1)<<52|1<<30, uint64(1)<<52|1
+	for _, dense := range []bool{false, true} {
+		a, b, want := New(p), New(p), New(p)
+		a.AddHash(low)
+		b.AddHash(high)
+		want.AddHash(high)
+		if dense {
+			a.toDense()
+			want.toDense()
+		}
+		if err := a.Merge(b); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Merge(New(p)); err != nil {
+			t.Fatal(err)
+		}
+		if !sameRegisters(a, want) || !sameRegisters(b, want) {
+			t.Fatalf("dense=%v: Merge() of ranks %d and %d did not keep the highest", dense, sparseEntry(low)&63, sparseEntry(high)&63)
+		}
+		if err := b.Merge(a); err != nil || !sameRegisters(b, want) {
+			t.Fatalf("dense=%v: Merge() the other way did not keep the highest rank", dense)
+		}
+	}
+}
+
+func TestMergePrecisionMismatch(t *testing.T) {
+	for _, other := range []*Sketch{New(10), New(14)} {
+		s := New(12)
+		s.AddString("a")
+		for i := range 5000 {
+			other.AddString(fmt.Sprint(i))
+		}
+		if err := s.Merge(other); !errors.Is(err, ErrPrecisionMismatch) {
+			t.Fatalf("Merge() of precision %d into 12 = %v, want ErrPrecisionMismatch", other.Precision(), err)
+		}
+		if s.Count() != 1 || !s.IsSparse() {
+			t.Fatalf("a failed Merge() changed the Sketch to %d values", s.Count())
+		}
+	}
+	a, b := NewStringCounter[string](12), NewStringCounter[string](13)
+	if err := a.Merge(b); !errors.Is(err, ErrPrecisionMismatch) {
+		t.Fatalf("Counter.Merge() of precisions 12 and 13 = %v, want ErrPrecisionMismatch", err)
+	}
+}
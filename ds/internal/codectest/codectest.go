@@ -0,0 +1,53 @@
+// Package codectest holds the round trip harness of the encoding tests of the containers: the codecs they
+// support, the values fuzz input turns into and the check that a container survives every codec.
+package codectest
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+// Codecs encode in and decode the result into out, through each encoding the containers support.
+var Codecs = map[string]func(in, out any) error{
+	"json": func(in, out any) error {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, out)
+	},
+	"gob": func(in, out any) error {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+			return err
+		}
+		return gob.NewDecoder(&buf).Decode(out)
+	},
+}
+
+// Values turns fuzz input into values, negative ones and duplicates included.
+func Values(data []byte) []int {
+	values := make([]int, len(data))
+	for i, b := range data {
+		values[i] = int(int8(b)) * (i%4 + 1)
+	}
+	return values
+}
+
+// RoundTrip passes in through every codec into a container made by empty, and checks that values
+// returns want for the result. name is the container in the failure messages.
+func RoundTrip[C any, V comparable](t testing.TB, name string, in C, empty func() C, values func(C) []V, want []V) {
+	t.Helper()
+	for codec, roundTrip := range Codecs {
+		out := empty()
+		if err := roundTrip(in, out); err != nil {
+			t.Fatalf("%s %s: %v", codec, name, err)
+		}
+		if got := values(out); !slices.Equal(got, want) {
+			t.Fatalf("%s %s = %v, want %v", codec, name, got, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Package gobslice encodes slices with encoding/gob. The containers of this module encode their values
+// as a slice, so their MarshalBinary and UnmarshalBinary share these two functions.
+package gobslice
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// Marshal encodes values with encoding/gob.
+func Marshal[T any](values []T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes values encoded by Marshal.
+func Unmarshal[T any](data []byte) ([]T, error) {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,55 @@
+// Package hashing provides the seedable, non-cryptographic 64-bit hash shared by the probabilistic
+// data structures of this module. Its output only depends on the input bytes and the seed, so sketches
+// built in different processes or on different machines can be merged and serialized.
+package hashing
+
+import "encoding/binary"
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// Sum64 returns the 64-bit hash of data for the given seed.
+// It is FNV-1a with the seed folded into the offset basis, followed by the MurmurHash3 finalizer
+// so that every input bit affects every output bit.
+func Sum64(data []byte, seed uint64) uint64 {
+	h := uint64(offset64) ^ Mix64(seed)
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= prime64
+	}
+	return Mix64(h ^ uint64(len(data)))
+}
+
+// Sum64String is Sum64 for a string, without converting it to a byte slice.
+func Sum64String(s string, seed uint64) uint64 {
+	h := uint64(offset64) ^ Mix64(seed)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= prime64
+	}
+	return Mix64(h ^ uint64(len(s)))
+}
+
+// Integer is the set of integer types, whose values SumInteger hashes.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// SumInteger is Sum64 of the unsigned varint of v converted to uint64, so negative values are sign extended.
+// Equal values always have the same hash, and hashing does not allocate.
+func SumInteger[T Integer](v T, seed uint64) uint64 {
+	var buf [binary.MaxVarintLen64]byte
+	return Sum64(binary.AppendUvarint(buf[:0], uint64(v)), seed)
+}
+
+// Mix64 is the 64-bit finalizer of MurmurHash3. It is a bijection that spreads the bits of x.
+func Mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,63 @@
+// Package itertest checks the iterators of the containers of this module: they yield the expected values
+// in order and never call yield again once it returned false, which is what a break in a range loop does.
+package itertest
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"testing"
+)
+
+// Seq checks that seq yields want in order and stops as soon as yield returns false, wherever that happens.
+func Seq[V comparable](t testing.TB, name string, seq iter.Seq[V], want []V) {
+	t.Helper()
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("%s yields %v, want %v", name, got, want)
+	}
+	Stops(t, name, seq, len(want))
+}
+
+// Unordered checks that seq yields the values of want in any order and stops as soon as yield returns false.
+func Unordered[V cmp.Ordered](t testing.TB, name string, seq iter.Seq[V], want []V) {
+	t.Helper()
+	got := slices.Sorted(seq)
+	if want = slices.Sorted(slices.Values(want)); !slices.Equal(got, want) {
+		t.Errorf("%s yields %v in some order, want %v", name, got, want)
+	}
+	Stops(t, name, seq, len(want))
+}
+
+// Seq2 checks that seq yields the pairs of keys and values in order and stops as soon as yield returns false.
+func Seq2[K, V comparable](t testing.TB, name string, seq iter.Seq2[K, V], keys []K, values []V) {
+	t.Helper()
+	var gotKeys []K
+	var gotValues []V
+	for k, v := range seq {
+		gotKeys = append(gotKeys, k)
+		gotValues = append(gotValues, v)
+	}
+	if !slices.Equal(gotKeys, keys) || !slices.Equal(gotValues, values) {
+		t.Errorf("%s yields %v: %v, want %v: %v", name, gotKeys, gotValues, keys, values)
+	}
+	Stops(t, name, func(yield func(K) bool) {
+		seq(func(k K, _ V) bool { return yield(k) })
+	}, len(keys))
+}
+
+// Stops checks that seq, which yields n values, calls yield no more after it returned false, for a
+// break after each of the n values.
+func Stops[V any](t testing.TB, name string, seq iter.Seq[V], n int) {
+	t.Helper()
+	for stop := range n {
+		calls := 0
+		seq(func(V) bool {
+			calls++
+			return calls <= stop
+		})
+		if calls != stop+1 {
+			t.Errorf("%s called yield %d times with a break after value %d", name, calls, stop+1)
+			return
+		}
+	}
+}
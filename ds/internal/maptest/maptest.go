@@ -0,0 +1,119 @@
+// Package maptest checks ordered maps of int keys against a plain map. The tree packages of this module
+// share it for their randomized tests and add the queries that only their own maps answer.
+package maptest
+
+import (
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+// KeySpace is the number of distinct keys Randomized uses, small enough that Deletes often hit.
+const KeySpace = 500
+
+// Map is the part of an ordered map the harness drives.
+type Map interface {
+	Put(key, value int)
+	Get(key int) (int, bool)
+	Delete(key int) bool
+	Min() (int, int, bool)
+	Max() (int, int, bool)
+	All() iter.Seq2[int, int]
+	Backward() iter.Seq2[int, int]
+	Len() int
+	Check() error
+}
+
+// Randomized applies steps random Puts and Deletes to m and to a reference map. After every change it
+// checks the invariants of m and compares it with the reference, then calls check, if not nil, with the
+// step, the reference and its keys in ascending order.
+func Randomized(t *testing.T, m Map, seed uint64, steps int, check func(step int, ref map[int]int, keys []int, r *rand.Rand)) {
+	t.Helper()
+	r := rand.New(rand.NewPCG(seed, seed))
+	ref := map[int]int{}
+	for step := range steps {
+		key := r.IntN(KeySpace)
+		// Bias towards Put so the map grows, then shrinks when Deletes catch up.
+		if r.IntN(10) < 6 {
+			m.Put(key, step)
+			ref[key] = step
+		} else {
+			_, want := ref[key]
+			if got := m.Delete(key); got != want {
+				t.Fatalf("step %d: Delete(%d) = %v, want %v", step, key, got, want)
+			}
+			delete(ref, key)
+		}
+		if err := m.Check(); err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+		keys := Compare(t, m, ref, r)
+		if check != nil {
+			check(step, ref, keys, r)
+		}
+	}
+}
+
+// Compare checks the length, the iterators, Min, Max and Get at a few random keys of m against ref,
+// and returns the keys of ref in ascending order.
+func Compare(t *testing.T, m Map, ref map[int]int, r *rand.Rand) []int {
+	t.Helper()
+	keys := slices.Sorted(maps.Keys(ref))
+	if m.Len() != len(keys) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(keys))
+	}
+	if got := Keys(m.All()); !slices.Equal(got, keys) {
+		t.Fatalf("All() = %v, want %v", got, keys)
+	}
+	backward := slices.Clone(keys)
+	slices.Reverse(backward)
+	if got := Keys(m.Backward()); !slices.Equal(got, backward) {
+		t.Fatalf("Backward() = %v, want %v", got, backward)
+	}
+	// Breaking out of a loop early is cheap to check on small maps only.
+	if len(keys) <= 16 {
+		itertest.Stops(t, "All()", keySeq(m.All()), len(keys))
+		itertest.Stops(t, "Backward()", keySeq(m.Backward()), len(keys))
+	}
+	minKey, _, ok := m.Min()
+	if ok != (len(keys) > 0) || ok && minKey != keys[0] {
+		t.Fatalf("Min() = %d, %v", minKey, ok)
+	}
+	maxKey, _, ok := m.Max()
+	if ok != (len(keys) > 0) || ok && maxKey != keys[len(keys)-1] {
+		t.Fatalf("Max() = %d, %v", maxKey, ok)
+	}
+	for range 4 {
+		key := Key(r)
+		value, ok := m.Get(key)
+		if want, wantOK := ref[key]; ok != wantOK || value != want {
+			t.Fatalf("Get(%d) = %d, %v, want %d, %v", key, value, ok, want, wantOK)
+		}
+	}
+	return keys
+}
+
+// Key returns a random key in [-1, KeySpace], one past each end of the keys Randomized uses.
+func Key(r *rand.Rand) int {
+	return r.IntN(KeySpace+2) - 1
+}
+
+// Keys collects the keys of seq.
+func Keys(seq iter.Seq2[int, int]) []int {
+	var keys []int
+	for key := range seq {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// keySeq drops the values of seq.
+func keySeq(seq iter.Seq2[int, int]) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		seq(func(key, _ int) bool { return yield(key) })
+	}
+}
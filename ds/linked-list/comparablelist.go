@@ -0,0 +1,45 @@
+package linkedlist
+
+// ComparableList is a LinkedList of comparable values.
+// On top of the predicate based lookups of LinkedList, it can look values up with ==.
+// For types that need a custom equality, use Find, IndexFunc and RemoveIf with a predicate instead.
+// The zero value is an empty list ready to use.
+type ComparableList[T comparable] struct {
+	LinkedList[T]
+}
+
+// NewComparable - Create a new linked list of comparable values.
+func NewComparable[T comparable]() *ComparableList[T] {
+	return &ComparableList[T]{}
+}
+
+// Contains - Checks if the list holds the given value.
+func (l *ComparableList[T]) Contains(value T) bool {
+	return l.IndexOf(value) >= 0
+}
+
+// IndexOf - Return the index of the first node holding the given value, -1 if there is none.
+func (l *ComparableList[T]) IndexOf(value T) int {
+	return l.IndexFunc(func(v T) bool { return v == value })
+}
+
+// RemoveValue - Remove the first node holding the given value.
+// It returns false if the value is not in the list.
+func (l *ComparableList[T]) RemoveValue(value T) bool {
+	var prev *Node[T]
+	for node := l.Head; node != nil; prev, node = node, node.Next {
+		if node.Value != value {
+			continue
+		}
+		if prev == nil {
+			l.Head = node.Next
+		} else {
+			prev.Next = node.Next
+		}
+		if node == l.Tail {
+			l.Tail = prev
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package linkedlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestComparableListZeroValue(t *testing.T) {
+	var l ComparableList[string]
+	if l.Contains("a") || l.IndexOf("a") != -1 || l.RemoveValue("a") {
+		t.Fatal("lookups on the zero value found a value")
+	}
+	l.Append("a")
+	l.Insert("b")
+	if !l.Contains("a") || l.IndexOf("a") != 1 {
+		t.Fatalf("IndexOf(a) = %d, want 1", l.IndexOf("a"))
+	}
+}
+
+func TestRemoveValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		remove int
+		ok     bool
+		want   []int
+	}{
+		{"empty", nil, 1, false, nil},
+		{"missing", []int{1, 2}, 3, false, []int{1, 2}},
+		{"only", []int{1}, 1, true, nil},
+		{"head", []int{1, 2, 3}, 1, true, []int{2, 3}},
+		{"middle", []int{1, 2, 3}, 2, true, []int{1, 3}},
+		{"tail", []int{1, 2, 3}, 3, true, []int{1, 2}},
+		{"first of duplicates", []int{2, 1, 2}, 2, true, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewComparable[int]()
+			for _, v := range tt.values {
+				l.Append(v)
+			}
+			if got := l.RemoveValue(tt.remove); got != tt.ok {
+				t.Fatalf("RemoveValue(%d) = %v, want %v", tt.remove, got, tt.ok)
+			}
+			checkList(t, &l.LinkedList, tt.want)
+		})
+	}
+}
+
+func TestLookups(t *testing.T) {
+	l := New[int]()
+	for _, v := range []int{5, 6, 7, 8} {
+		l.Append(v)
+	}
+	even := func(v int) bool { return v%2 == 0 }
+	if v, ok := l.Find(even); !ok || v != 6 {
+		t.Fatalf("Find(even) = %d, %v, want 6, true", v, ok)
+	}
+	if _, ok := l.Find(func(v int) bool { return v > 8 }); ok {
+		t.Fatal("Find() found a value above 8")
+	}
+	if i := l.IndexFunc(even); i != 1 {
+		t.Fatalf("IndexFunc(even) = %d, want 1", i)
+	}
+	if i := l.IndexFunc(func(int) bool { return false }); i != -1 {
+		t.Fatalf("IndexFunc(never) = %d, want -1", i)
+	}
+	for i, want := range []int{5, 6, 7, 8} {
+		if v, err := l.At(i); err != nil || v != want {
+			t.Fatalf("At(%d) = %d, %v, want %d", i, v, err, want)
+		}
+	}
+	for _, i := range []int{-1, 4, 100} {
+		if _, err := l.At(i); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("At(%d) = %v, want ErrIndexOutOfRange", i, err)
+		}
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		pred    func(int) bool
+		removed int
+		want    []int
+	}{
+		{"empty", nil, func(int) bool { return true }, 0, nil},
+		{"none", []int{1, 2}, func(int) bool { return false }, 0, []int{1, 2}},
+		{"all", []int{1, 2, 3}, func(int) bool { return true }, 3, nil},
+		{"even", []int{1, 2, 3, 4}, func(v int) bool { return v%2 == 0 }, 2, []int{1, 3}},
+		{"odd", []int{1, 2, 3, 4, 5}, func(v int) bool { return v%2 == 1 }, 3, []int{2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New[int]()
+			for _, v := range tt.values {
+				l.Append(v)
+			}
+			if got := l.RemoveIf(tt.pred); got != tt.removed {
+				t.Fatalf("RemoveIf() = %d, want %d", got, tt.removed)
+			}
+			checkList(t, l, tt.want)
+		})
+	}
+}
+
+func TestInsertAtRemoveAt(t *testing.T) {
+	l := New[int]()
+	for _, i := range []int{-1, 1} {
+		if err := l.InsertAt(i, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("InsertAt(%d) on an empty list = %v, want ErrIndexOutOfRange", i, err)
+		}
+		if _, err := l.RemoveAt(i); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Fatalf("RemoveAt(%d) on an empty list = %v, want ErrIndexOutOfRange", i, err)
+		}
+	}
+	if _, err := l.RemoveAt(0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("RemoveAt(0) on an empty list = %v, want ErrIndexOutOfRange", err)
+	}
+	for _, step := range []struct{ i, v int }{{0, 2}, {0, 0}, {1, 1}, {3, 3}} {
+		if err := l.InsertAt(step.i, step.v); err != nil {
+			t.Fatalf("InsertAt(%d, %d) = %v", step.i, step.v, err)
+		}
+	}
+	checkList(t, l, []int{0, 1, 2, 3})
+	if err := l.InsertAt(5, 5); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("InsertAt(5) = %v, want ErrIndexOutOfRange", err)
+	}
+	if _, err := l.RemoveAt(4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("RemoveAt(4) = %v, want ErrIndexOutOfRange", err)
+	}
+	for _, step := range []struct{ i, want int }{{3, 3}, {1, 1}, {0, 0}, {0, 2}} {
+		if v, err := l.RemoveAt(step.i); err != nil || v != step.want {
+			t.Fatalf("RemoveAt(%d) = %d, %v, want %d", step.i, v, err, step.want)
+		}
+	}
+	checkList(t, l, nil)
+}
@@ -0,0 +1,256 @@
+package linkedlist
+
+import "iter"
+
+// # Doubly Linked List
+
+// A Doubly Linked List contains an extra pointer, typically called the previous pointer, together with the next pointer and data which are there in the singly linked list.
+// Because every node knows both of its neighbours and the list keeps a pointer to its last node (the tail), a node can be inserted or removed at either end, or next to any node we already hold, in O(1).
+
+// ## Advantages over singly linked list:
+// - It can be traversed in both forward and backward direction.
+// - The delete operation is more efficient if a pointer to the node to be deleted is given.
+// - We can quickly insert a new node before a given node.
+
+// ## Drawbacks:
+// - Every node requires extra space for the previous pointer.
+// - All operations require an extra pointer (previous) to be maintained.
+
+// ## Usages:
+// - LRU caches, where a recently used node is moved to the front and the least recently used one is evicted from the back.
+// - Deques, where items are pushed and popped at both ends.
+// - Undo/Redo in editors, Forward/Backward navigation in browsers.
+
+// DoublyNode is a node in a doubly linked list.
+type DoublyNode[T any] struct {
+	Value      T
+	next, prev *DoublyNode[T]
+
+	// list is the list the node belongs to, nil once the node is removed.
+	list *DoublyLinkedList[T]
+}
+
+// Next - Return the next node, nil if node is the last one or was removed from its list.
+func (n *DoublyNode[T]) Next() *DoublyNode[T] {
+	return n.next
+}
+
+// Prev - Return the previous node, nil if node is the first one or was removed from its list.
+func (n *DoublyNode[T]) Prev() *DoublyNode[T] {
+	return n.prev
+}
+
+// DoublyLinkedList is a doubly linked list. Its nodes are only linked through its methods, so the
+// head, the tail and the size always agree.
+type DoublyLinkedList[T any] struct {
+	head, tail *DoublyNode[T]
+	size       int
+}
+
+// NewDoubly - Create a new doubly linked list.
+func NewDoubly[T any]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{}
+}
+
+// Len - Returns the number of nodes in the list.
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.size
+}
+
+// Front - Return the first node, nil if the list is empty.
+func (l *DoublyLinkedList[T]) Front() *DoublyNode[T] {
+	return l.head
+}
+
+// Back - Return the last node, nil if the list is empty.
+func (l *DoublyLinkedList[T]) Back() *DoublyNode[T] {
+	return l.tail
+}
+
+// IsEmpty - Checks if the list is empty.
+func (l *DoublyLinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// PushFront - Insert a new node at the beginning of the list and return it.
+func (l *DoublyLinkedList[T]) PushFront(value T) *DoublyNode[T] {
+	node := &DoublyNode[T]{Value: value, list: l}
+	l.linkBefore(node, l.head)
+	return node
+}
+
+// PushBack - Insert a new node at the end of the list and return it.
+func (l *DoublyLinkedList[T]) PushBack(value T) *DoublyNode[T] {
+	node := &DoublyNode[T]{Value: value, list: l}
+	l.linkAfter(node, l.tail)
+	return node
+}
+
+// PopFront - Remove the first node and return its value, false if the list is empty.
+func (l *DoublyLinkedList[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var empty T
+		return empty, false
+	}
+	node := l.head
+	l.unlink(node)
+	return node.Value, true
+}
+
+// PopBack - Remove the last node and return its value, false if the list is empty.
+func (l *DoublyLinkedList[T]) PopBack() (T, bool) {
+	if l.tail == nil {
+		var empty T
+		return empty, false
+	}
+	node := l.tail
+	l.unlink(node)
+	return node.Value, true
+}
+
+// InsertBefore - Insert a new node right before mark and return it.
+// It returns nil if mark does not belong to the list.
+func (l *DoublyLinkedList[T]) InsertBefore(value T, mark *DoublyNode[T]) *DoublyNode[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	node := &DoublyNode[T]{Value: value, list: l}
+	l.linkBefore(node, mark)
+	return node
+}
+
+// InsertAfter - Insert a new node right after mark and return it.
+// It returns nil if mark does not belong to the list.
+func (l *DoublyLinkedList[T]) InsertAfter(value T, mark *DoublyNode[T]) *DoublyNode[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	node := &DoublyNode[T]{Value: value, list: l}
+	l.linkAfter(node, mark)
+	return node
+}
+
+// Remove - Remove the given node from the list.
+// It returns false if the node does not belong to the list.
+func (l *DoublyLinkedList[T]) Remove(node *DoublyNode[T]) bool {
+	if node == nil || node.list != l {
+		return false
+	}
+	l.unlink(node)
+	return true
+}
+
+// MoveToFront - Move the given node to the beginning of the list.
+// It returns false if the node does not belong to the list.
+func (l *DoublyLinkedList[T]) MoveToFront(node *DoublyNode[T]) bool {
+	if node == nil || node.list != l {
+		return false
+	}
+	if node != l.head {
+		l.unlink(node)
+		node.list = l
+		l.linkBefore(node, l.head)
+	}
+	return true
+}
+
+// MoveToBack - Move the given node to the end of the list.
+// It returns false if the node does not belong to the list.
+func (l *DoublyLinkedList[T]) MoveToBack(node *DoublyNode[T]) bool {
+	if node == nil || node.list != l {
+		return false
+	}
+	if node != l.tail {
+		l.unlink(node)
+		node.list = l
+		l.linkAfter(node, l.tail)
+	}
+	return true
+}
+
+// Clear - Remove all nodes from the list.
+func (l *DoublyLinkedList[T]) Clear() {
+	for node := l.head; node != nil; {
+		next := node.next
+		node.next, node.prev, node.list = nil, nil, nil
+		node = next
+	}
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// All - Return an iterator over the values of the list from head to tail.
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.head; node != nil; node = node.next {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - Return an iterator over the values of the list from tail to head.
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.tail; node != nil; node = node.prev {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// linkBefore links node right before mark, or at the end of the list if mark is nil.
+func (l *DoublyLinkedList[T]) linkBefore(node, mark *DoublyNode[T]) {
+	if mark == nil {
+		l.linkAfter(node, l.tail)
+		return
+	}
+	node.prev, node.next = mark.prev, mark
+	if mark.prev == nil {
+		l.head = node
+	} else {
+		mark.prev.next = node
+	}
+	mark.prev = node
+	l.size++
+}
+
+// linkAfter links node right after mark, or at the beginning of the list if mark is nil.
+func (l *DoublyLinkedList[T]) linkAfter(node, mark *DoublyNode[T]) {
+	if mark == nil {
+		node.prev, node.next = nil, l.head
+		if l.head == nil {
+			l.tail = node
+		} else {
+			l.head.prev = node
+		}
+		l.head = node
+		l.size++
+		return
+	}
+	node.prev, node.next = mark, mark.next
+	if mark.next == nil {
+		l.tail = node
+	} else {
+		mark.next.prev = node
+	}
+	mark.next = node
+	l.size++
+}
+
+// unlink detaches node from the list.
+func (l *DoublyLinkedList[T]) unlink(node *DoublyNode[T]) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.next, node.prev, node.list = nil, nil, nil
+	l.size--
+}
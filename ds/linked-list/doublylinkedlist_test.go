@@ -0,0 +1,106 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkDoubly fails unless l holds want in both directions, with matching Front, Back, Len and links.
+func checkDoubly(t *testing.T, l *DoublyLinkedList[int], want []int) {
+	t.Helper()
+	if got := slices.Collect(l.All()); !slices.Equal(got, want) {
+		t.Fatalf("All() = %v, want %v", got, want)
+	}
+	backward := slices.Clone(want)
+	slices.Reverse(backward)
+	if got := slices.Collect(l.Backward()); !slices.Equal(got, backward) {
+		t.Fatalf("Backward() = %v, want %v", got, backward)
+	}
+	if l.Len() != len(want) || l.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	if len(want) == 0 {
+		if l.Front() != nil || l.Back() != nil {
+			t.Fatal("Front() or Back() is not nil on an empty list")
+		}
+		return
+	}
+	if front := l.Front(); front.Prev() != nil || front.Value != want[0] {
+		t.Fatalf("Front() = %d, want %d", front.Value, want[0])
+	}
+	if back := l.Back(); back.Next() != nil || back.Value != want[len(want)-1] {
+		t.Fatalf("Back() = %d, want %d", back.Value, want[len(want)-1])
+	}
+	for node := l.Front(); node != nil; node = node.Next() {
+		if node.list != l || node.Next() != nil && node.Next().Prev() != node {
+			t.Fatalf("node %d is not linked both ways", node.Value)
+		}
+	}
+}
+
+func TestDoublyLinkedList(t *testing.T) {
+	l := NewDoubly[int]()
+	checkDoubly(t, l, nil)
+
+	two := l.PushBack(2)
+	checkDoubly(t, l, []int{2})
+	one := l.PushFront(1)
+	checkDoubly(t, l, []int{1, 2})
+	four := l.InsertAfter(4, two)
+	checkDoubly(t, l, []int{1, 2, 4})
+	l.InsertBefore(3, four)
+	checkDoubly(t, l, []int{1, 2, 3, 4})
+	l.InsertBefore(0, one)
+	checkDoubly(t, l, []int{0, 1, 2, 3, 4})
+
+	l.MoveToFront(four)
+	checkDoubly(t, l, []int{4, 0, 1, 2, 3})
+	l.MoveToBack(four)
+	checkDoubly(t, l, []int{0, 1, 2, 3, 4})
+	l.MoveToBack(four)
+	checkDoubly(t, l, []int{0, 1, 2, 3, 4})
+
+	l.Remove(two)
+	checkDoubly(t, l, []int{0, 1, 3, 4})
+	if v, ok := l.PopFront(); !ok || v != 0 {
+		t.Fatalf("PopFront() = %d, %v, want 0, true", v, ok)
+	}
+	if v, ok := l.PopBack(); !ok || v != 4 {
+		t.Fatalf("PopBack() = %d, %v, want 4, true", v, ok)
+	}
+	checkDoubly(t, l, []int{1, 3})
+
+	l.Clear()
+	checkDoubly(t, l, nil)
+	if _, ok := l.PopFront(); ok {
+		t.Fatal("PopFront() on an empty list = true")
+	}
+	if _, ok := l.PopBack(); ok {
+		t.Fatal("PopBack() on an empty list = true")
+	}
+	l.PushBack(5)
+	checkDoubly(t, l, []int{5})
+}
+
+func TestDoublyForeignNode(t *testing.T) {
+	l, other := NewDoubly[int](), NewDoubly[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	foreign := other.PushBack(3)
+	removed := l.PushBack(4)
+	l.Remove(removed)
+
+	for _, node := range []*DoublyNode[int]{foreign, removed, nil} {
+		if l.MoveToFront(node) || l.MoveToBack(node) || l.Remove(node) {
+			t.Fatal("a node of another list was accepted")
+		}
+		if l.InsertBefore(0, node) != nil || l.InsertAfter(0, node) != nil {
+			t.Fatal("a node of another list was used as a mark")
+		}
+	}
+	checkDoubly(t, l, []int{1, 2})
+	checkDoubly(t, other, []int{3})
+	if removed.Next() != nil || removed.Prev() != nil {
+		t.Fatal("a removed node still points into its list")
+	}
+}
@@ -0,0 +1,104 @@
+package linkedlist
+
+import (
+	"encoding/json"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/gobslice"
+)
+
+// The linked lists encode as their values from head to tail: a JSON array for encoding/json and a
+// gob encoded slice for encoding.BinaryMarshaler, which encoding/gob also uses to encode them.
+
+// MarshalJSON - Encode the linked list as a JSON array, from head to tail.
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.values())
+}
+
+// UnmarshalJSON - Replace the content of the linked list with a JSON array of values.
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	l.load(values)
+	return nil
+}
+
+// MarshalBinary - Encode the linked list with encoding/gob, from head to tail.
+func (l *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(l.values())
+}
+
+// UnmarshalBinary - Replace the content of the linked list with values encoded by MarshalBinary.
+func (l *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	l.load(values)
+	return nil
+}
+
+// MarshalJSON - Encode the list as a JSON array, from head to tail.
+func (l *DoublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.values())
+}
+
+// UnmarshalJSON - Replace the content of the list with a JSON array of values.
+func (l *DoublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	l.load(values)
+	return nil
+}
+
+// MarshalBinary - Encode the list with encoding/gob, from head to tail.
+func (l *DoublyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(l.values())
+}
+
+// UnmarshalBinary - Replace the content of the list with values encoded by MarshalBinary.
+func (l *DoublyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	l.load(values)
+	return nil
+}
+
+// values returns the values of the linked list from head to tail.
+func (l *LinkedList[T]) values() []T {
+	values := []T{}
+	for v := range l.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// load replaces the content of the linked list with values.
+func (l *LinkedList[T]) load(values []T) {
+	l.Head, l.Tail = nil, nil
+	for _, v := range values {
+		l.Append(v)
+	}
+}
+
+// values returns the values of the list from head to tail.
+func (l *DoublyLinkedList[T]) values() []T {
+	values := make([]T, 0, l.size)
+	for v := range l.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// load replaces the content of the list with values.
+func (l *DoublyLinkedList[T]) load(values []T) {
+	l.Clear()
+	for _, v := range values {
+		l.PushBack(v)
+	}
+}
@@ -0,0 +1,38 @@
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/codectest"
+)
+
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0})
+	f.Add([]byte("a linked list"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := codectest.Values(data)
+		list, doubly := New[int](), NewDoubly[int]()
+		for _, v := range values {
+			list.Append(v)
+			doubly.PushBack(v)
+		}
+
+		listValues := func(l *LinkedList[int]) []int {
+			got := l.values()
+			if len(got) > 0 && (l.Tail == nil || l.Tail.Value != got[len(got)-1]) {
+				t.Fatalf("decoded LinkedList Tail = %v, want the node of %d", l.Tail, got[len(got)-1])
+			}
+			return got
+		}
+		codectest.RoundTrip(t, "LinkedList", list, New[int], listValues, values)
+		doublyValues := func(d *DoublyLinkedList[int]) []int {
+			got := d.values()
+			if d.Len() != len(got) {
+				t.Fatalf("decoded DoublyLinkedList Len() = %d, want %d", d.Len(), len(got))
+			}
+			return got
+		}
+		codectest.RoundTrip(t, "DoublyLinkedList", doubly, NewDoubly[int], doublyValues, values)
+	})
+}
@@ -0,0 +1,25 @@
+package linkedlist
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+func TestIterators(t *testing.T) {
+	for _, values := range [][]int{nil, {1}, {1, 2, 3, 4}} {
+		l, d := New[int](), NewDoubly[int]()
+		indexes := make([]int, len(values))
+		backward := make([]int, len(values))
+		for i, v := range values {
+			l.Append(v)
+			d.PushBack(v)
+			indexes[i] = i
+			backward[len(values)-1-i] = v
+		}
+		itertest.Seq(t, "LinkedList.All", l.All(), values)
+		itertest.Seq2(t, "LinkedList.Enumerate", l.Enumerate(), indexes, values)
+		itertest.Seq(t, "DoublyLinkedList.All", d.All(), values)
+		itertest.Seq(t, "DoublyLinkedList.Backward", d.Backward(), backward)
+	}
+}
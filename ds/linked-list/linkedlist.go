@@ -1,6 +1,12 @@
 package linkedlist
 
-import "fmt"
+import (
+	"errors"
+	"iter"
+)
+
+// ErrIndexOutOfRange is returned when an index does not point to a node of the list.
+var ErrIndexOutOfRange = errors.New("linkedlist: index out of range")
 
 // # Linked List
 
@@ -46,7 +52,8 @@ type Node[T any] struct {
 	Next  *Node[T]
 }
 
-// LinkedList is a linked list.
+// LinkedList is a linked list. Head is its first node and Tail its last one, both nil when the list is empty.
+// Every method keeps them in sync; code that links nodes by hand must keep Tail pointing at the last node.
 type LinkedList[T any] struct {
 	Head *Node[T]
 	Tail *Node[T]
@@ -55,7 +62,7 @@ type LinkedList[T any] struct {
 // Some Basic Operations on Linked List
 // 1. Insertion
 // 2. Deletion
-// 3. Search
+// 3. Search (Find, IndexFunc, and Contains/IndexOf on ComparableList)
 // 4. Traversal
 
 // New - Create a new linked list.
@@ -68,92 +75,258 @@ func (l *LinkedList[T]) Insert(value T) {
 	node := &Node[T]{Value: value}
 	node.Next = l.Head
 	l.Head = node
+	if l.Tail == nil {
+		l.Tail = node
+	}
+}
+
+// Append - Insert a new node at the end of the linked list.
+func (l *LinkedList[T]) Append(value T) {
+	node := &Node[T]{Value: value}
+	if l.Tail == nil {
+		l.Head = node
+	} else {
+		l.Tail.Next = node
+	}
+	l.Tail = node
+}
+
+// Delete - Delete the first node from the linked list and return its value, false if the list is empty.
+func (l *LinkedList[T]) Delete() (T, bool) {
+	if l.Head == nil {
+		var empty T
+		return empty, false
+	}
+	node := l.Head
+	l.Head = node.Next
+	if l.Head == nil {
+		l.Tail = nil
+	}
+	return node.Value, true
 }
 
-// Delete - Delete the first node from the linked list.
-func (l *LinkedList[T]) Delete() {
-	l.Head = l.Head.Next
+// Find - Return the first value for which pred returns true, false if there is none.
+func (l *LinkedList[T]) Find(pred func(T) bool) (T, bool) {
+	for node := l.Head; node != nil; node = node.Next {
+		if pred(node.Value) {
+			return node.Value, true
+		}
+	}
+	var empty T
+	return empty, false
 }
 
-// Search - Search for a node with the given value.
-// func (l *LinkedList[T]) Search(value T) bool {
-// 	for node := l.Head; node != nil; node = node.Next {
-// 		if node.Value == value {
-// 			return true
-// 		}
-// 	}
-// 	return false
-// }
+// IndexFunc - Return the index of the first value for which pred returns true, -1 if there is none.
+func (l *LinkedList[T]) IndexFunc(pred func(T) bool) int {
+	i := 0
+	for node := l.Head; node != nil; node = node.Next {
+		if pred(node.Value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
 
-// Traverse - Traverse the linked list.
-func (l *LinkedList[T]) Traverse() {
+// RemoveIf - Remove every node for which pred returns true and return how many were removed.
+func (l *LinkedList[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+	var prev *Node[T]
 	for node := l.Head; node != nil; node = node.Next {
-		fmt.Println(node.Value)
+		if !pred(node.Value) {
+			prev = node
+			continue
+		}
+		if prev == nil {
+			l.Head = node.Next
+		} else {
+			prev.Next = node.Next
+		}
+		if node == l.Tail {
+			l.Tail = prev
+		}
+		removed++
+	}
+	return removed
+}
+
+// At - Return the value at the given index, ErrIndexOutOfRange if there is no such index.
+func (l *LinkedList[T]) At(i int) (T, error) {
+	node := l.nodeAt(i)
+	if node == nil {
+		var empty T
+		return empty, ErrIndexOutOfRange
+	}
+	return node.Value, nil
+}
+
+// InsertAt - Insert a new node so that it ends up at the given index.
+// Index 0 inserts at the beginning and index equal to the length appends at the end.
+func (l *LinkedList[T]) InsertAt(i int, value T) error {
+	if i == 0 {
+		l.Insert(value)
+		return nil
+	}
+	prev := l.nodeAt(i - 1)
+	if prev == nil {
+		return ErrIndexOutOfRange
+	}
+	prev.Next = &Node[T]{Value: value, Next: prev.Next}
+	if prev == l.Tail {
+		l.Tail = prev.Next
+	}
+	return nil
+}
+
+// RemoveAt - Remove the node at the given index and return its value.
+func (l *LinkedList[T]) RemoveAt(i int) (T, error) {
+	var empty T
+	if i == 0 {
+		if value, ok := l.Delete(); ok {
+			return value, nil
+		}
+		return empty, ErrIndexOutOfRange
+	}
+	prev := l.nodeAt(i - 1)
+	if prev == nil || prev.Next == nil {
+		return empty, ErrIndexOutOfRange
+	}
+	node := prev.Next
+	prev.Next = node.Next
+	if node == l.Tail {
+		l.Tail = prev
+	}
+	return node.Value, nil
+}
+
+// nodeAt returns the node at the given index, nil if there is no such index.
+func (l *LinkedList[T]) nodeAt(i int) *Node[T] {
+	if i < 0 {
+		return nil
+	}
+	node := l.Head
+	for ; node != nil && i > 0; i-- {
+		node = node.Next
+	}
+	return node
+}
+
+// Traverse - Traverse the linked list, calling f for each value.
+func (l *LinkedList[T]) Traverse(f func(T)) {
+	for node := l.Head; node != nil; node = node.Next {
+		f(node.Value)
 	}
 }
 
 // Reverse - Reverse the linked list.
 func (l *LinkedList[T]) Reverse() {
 	var prev *Node[T]
-	for node := l.Head; node != nil; node = node.Next {
-		node.Next, prev, l.Head = prev, node, node.Next
+	node := l.Head
+	l.Tail = node
+	for node != nil {
+		next := node.Next
+		node.Next = prev
+		prev, node = node, next
 	}
 	l.Head = prev
 }
 
 // ReverseRecursive - Reverse the linked list recursively.
 func (l *LinkedList[T]) ReverseRecursive() {
+	l.Tail = l.Head
 	l.Head = l.reverseRecursive(l.Head)
 }
 
+// reverseRecursive reverses the list starting at node and returns its new first node.
 func (l *LinkedList[T]) reverseRecursive(node *Node[T]) *Node[T] {
 	if node == nil || node.Next == nil {
 		return node
 	}
-	node.Next = l.reverseRecursive(node.Next)
-	return node.Next
+	head := l.reverseRecursive(node.Next)
+	node.Next.Next = node
+	node.Next = nil
+	return head
 }
 
-// ReverseKGroup - Reverse the linked list in groups of given size.
+// ReverseKGroup - Reverse the linked list in groups of given size. A last group of fewer nodes is reversed too.
 func (l *LinkedList[T]) ReverseKGroup(k int) {
-	l.Head = l.reverseKGroup(l.Head, k)
-}
-
-func (l *LinkedList[T]) reverseKGroup(node *Node[T], k int) *Node[T] {
-	if node == nil || node.Next == nil {
-		return node
+	if k < 2 {
+		return
 	}
-	var prev *Node[T]
-	for i := 0; i < k && node != nil; i++ {
-		next := node.Next
-		node.Next = prev
-		prev = node
-		node = next
+	var head, tail *Node[T]
+	for node := l.Head; node != nil; {
+		// Reverse the next k nodes; first ends up last in the group.
+		first := node
+		var prev *Node[T]
+		for i := 0; i < k && node != nil; i++ {
+			next := node.Next
+			node.Next = prev
+			prev, node = node, next
+		}
+		if tail == nil {
+			head = prev
+		} else {
+			tail.Next = prev
+		}
+		tail = first
 	}
-	if node != nil {
-		node.Next = l.reverseKGroup(node.Next, k)
-	}
-	return prev
+	l.Head, l.Tail = head, tail
 }
 
 // ReverseKGroupRecursive - Reverse the linked list in groups of given size recursively.
+// A last group of fewer nodes is reversed too.
 func (l *LinkedList[T]) ReverseKGroupRecursive(k int) {
+	if k < 2 {
+		return
+	}
 	l.Head = l.reverseKGroupRecursive(l.Head, k)
+	l.resetTail()
 }
 
+// reverseKGroupRecursive reverses the groups of the list starting at node and returns its new first node.
 func (l *LinkedList[T]) reverseKGroupRecursive(node *Node[T], k int) *Node[T] {
-	if node == nil || node.Next == nil {
-		return node
+	if node == nil {
+		return nil
 	}
+	first := node
 	var prev *Node[T]
 	for i := 0; i < k && node != nil; i++ {
 		next := node.Next
 		node.Next = prev
-		prev = node
-		node = next
-	}
-	if node != nil {
-		node.Next = l.reverseKGroupRecursive(node.Next, k)
+		prev, node = node, next
 	}
+	first.Next = l.reverseKGroupRecursive(node, k)
 	return prev
 }
+
+// resetTail points Tail at the last node again, after a reversal moved it.
+func (l *LinkedList[T]) resetTail() {
+	l.Tail = l.Head
+	for l.Tail != nil && l.Tail.Next != nil {
+		l.Tail = l.Tail.Next
+	}
+}
+
+// All - Return an iterator over the values of the linked list from head to tail.
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.Head; node != nil; node = node.Next {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate - Return an iterator over the indexes and values of the linked list from head to tail.
+func (l *LinkedList[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for node := l.Head; node != nil; node = node.Next {
+			if !yield(i, node.Value) {
+				return
+			}
+			i++
+		}
+	}
+}
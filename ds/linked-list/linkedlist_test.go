@@ -0,0 +1,110 @@
+package linkedlist
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checkList fails unless l holds want from head to tail and Tail points at the last node.
+func checkList(t *testing.T, l *LinkedList[int], want []int) {
+	t.Helper()
+	if got := l.values(); !slices.Equal(got, want) {
+		t.Fatalf("values = %v, want %v", got, want)
+	}
+	if len(want) == 0 {
+		if l.Head != nil || l.Tail != nil {
+			t.Fatalf("Head, Tail = %v, %v on an empty list, want nil", l.Head, l.Tail)
+		}
+		return
+	}
+	if l.Tail == nil || l.Tail.Next != nil || l.Tail.Value != want[len(want)-1] {
+		t.Fatalf("Tail = %+v, want the last node holding %d", l.Tail, want[len(want)-1])
+	}
+}
+
+func TestLinkedListTail(t *testing.T) {
+	l, want := New[int](), []int{}
+	step := func(name string, f func()) {
+		t.Helper()
+		f()
+		t.Run(name, func(t *testing.T) { checkList(t, l, want) })
+	}
+	step("Append", func() { l.Append(2); want = append(want, 2) })
+	step("Insert", func() { l.Insert(1); want = slices.Insert(want, 0, 1) })
+	step("InsertAt end", func() { l.InsertAt(2, 4); want = append(want, 4) })
+	step("InsertAt middle", func() { l.InsertAt(2, 3); want = slices.Insert(want, 2, 3) })
+	step("RemoveAt end", func() { l.RemoveAt(3); want = want[:3] })
+	step("RemoveIf last", func() { l.RemoveIf(func(v int) bool { return v == 3 }); want = want[:2] })
+	step("Append after RemoveIf", func() { l.Append(5); want = append(want, 5) })
+	step("Reverse", func() { l.Reverse(); slices.Reverse(want) })
+	step("Append after Reverse", func() { l.Append(6); want = append(want, 6) })
+	step("RemoveIf all", func() { l.RemoveIf(func(int) bool { return true }); want = want[:0] })
+	step("Append after emptying", func() { l.Append(7); want = append(want, 7) })
+	step("Delete last", func() { l.Delete(); want = want[:0] })
+	step("Insert into empty", func() { l.Insert(8); want = append(want, 8) })
+	step("RemoveAt only", func() { l.RemoveAt(0); want = want[:0] })
+}
+
+// reversedGroups reverses every group of k values of values, the last short group included.
+func reversedGroups(values []int, k int) []int {
+	want := slices.Clone(values)
+	if k < 2 {
+		return want
+	}
+	for i := 0; i < len(want); i += k {
+		slices.Reverse(want[i:min(i+k, len(want))])
+	}
+	return want
+}
+
+func TestReverse(t *testing.T) {
+	reversals := map[string]func(l *LinkedList[int], k int){
+		"Reverse":                func(l *LinkedList[int], _ int) { l.Reverse() },
+		"ReverseRecursive":       func(l *LinkedList[int], _ int) { l.ReverseRecursive() },
+		"ReverseKGroup":          (*LinkedList[int]).ReverseKGroup,
+		"ReverseKGroupRecursive": (*LinkedList[int]).ReverseKGroupRecursive,
+	}
+	for name, reverse := range reversals {
+		for n := range 8 {
+			values := make([]int, n)
+			for i := range values {
+				values[i] = i
+			}
+			// k=0 and k=1 leave the list alone, k=3 leaves a short last group for most n, k=n and k>n
+			// reverse the whole list.
+			for _, k := range []int{0, 1, 2, 3, n, n + 1} {
+				t.Run(fmt.Sprintf("%s/n=%d/k=%d", name, n, k), func(t *testing.T) {
+					l := New[int]()
+					for _, v := range values {
+						l.Append(v)
+					}
+					reverse(l, k)
+					want := reversedGroups(values, k)
+					if name == "Reverse" || name == "ReverseRecursive" {
+						want = slices.Clone(values)
+						slices.Reverse(want)
+					}
+					checkList(t, l, want)
+					l.Append(-1)
+					checkList(t, l, append(want, -1))
+				})
+			}
+		}
+	}
+}
+
+func TestDeleteEmpty(t *testing.T) {
+	l := New[int]()
+	if _, ok := l.Delete(); ok {
+		t.Fatal("Delete() on an empty list = true")
+	}
+	l.Append(1)
+	if v, ok := l.Delete(); !ok || v != 1 {
+		t.Fatalf("Delete() = %d, %v, want 1, true", v, ok)
+	}
+	if _, ok := l.Delete(); ok {
+		t.Fatal("Delete() on a drained list = true")
+	}
+	checkList(t, l, nil)
+}
@@ -0,0 +1,186 @@
+package priorityqueue
+
+import "cmp"
+
+// # Priority Queue - Data Structure
+
+// A Priority Queue is an abstract data type similar to a queue, except that every element has a priority. An element with high priority is served before an element with low priority. What "high priority" means is decided by a less function: the element for which less reports true against every other element is served first, so a less of a < b gives a min priority queue and a less of a > b gives a max priority queue.
+
+// ## Binary Heap implementation
+
+// The queue is stored as a binary heap in a slice: the children of the element at index i are at 2*i+1 and 2*i+2, and every element is "less" than or equal to its children. The root at index 0 is therefore the next element to be served.
+
+// - Push: append the element at the end and sift it up until its parent is not greater. O(log n)
+// - Pop: swap the root with the last element, remove it and sift the new root down. O(log n)
+// - Peek: return the root. O(1)
+// - Fix/Update/Remove: every pushed element gets a handle that remembers its index in the heap, so an element whose priority changed can be sifted into place, or removed, in O(log n).
+
+// ## Usages:
+// - Schedulers, where the job with the nearest deadline or highest priority runs next.
+// - Dijkstra's and Prim's algorithms, where the closest vertex is picked next and its distance is lowered with Update.
+// - Event driven simulation, Huffman coding, merging k sorted lists, top-k problems.
+
+// Item is a handle to an element in a PriorityQueue.
+type Item[T any] struct {
+	Value T
+	// index is the position of the item in the heap, -1 once it is removed.
+	index int
+}
+
+// PriorityQueue is a priority queue backed by a binary heap.
+type PriorityQueue[T any] struct {
+	items []*Item[T]
+	less  func(a, b T) bool
+}
+
+// New returns a new PriorityQueue that serves first the element that is less than all others.
+func New[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// NewMin returns a new PriorityQueue that serves the smallest element first.
+func NewMin[T cmp.Ordered]() *PriorityQueue[T] {
+	return New(func(a, b T) bool { return a < b })
+}
+
+// NewMax returns a new PriorityQueue that serves the largest element first.
+func NewMax[T cmp.Ordered]() *PriorityQueue[T] {
+	return New(func(a, b T) bool { return a > b })
+}
+
+// Push - adds a value to the queue and returns its handle.
+func (pq *PriorityQueue[T]) Push(value T) *Item[T] {
+	item := &Item[T]{Value: value, index: len(pq.items)}
+	pq.items = append(pq.items, item)
+	pq.up(item.index)
+	return item
+}
+
+// Pop - removes and returns the value with the highest priority, false if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.items) == 0 {
+		var empty T
+		return empty, false
+	}
+	return pq.removeAt(0), true
+}
+
+// Peek - returns the value with the highest priority without removing it, false if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		var empty T
+		return empty, false
+	}
+	return pq.items[0].Value, true
+}
+
+// Len - returns the number of values in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// IsEmpty - returns true if the queue is empty.
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// Clear - removes all values from the queue. Handles of removed values are no longer valid.
+func (pq *PriorityQueue[T]) Clear() {
+	for i, item := range pq.items {
+		item.index = -1
+		pq.items[i] = nil
+	}
+	pq.items = pq.items[:0]
+}
+
+// Fix - restores the heap order after the Value of item was changed in place.
+// It returns false if item is not in the queue.
+func (pq *PriorityQueue[T]) Fix(item *Item[T]) bool {
+	if !pq.owns(item) {
+		return false
+	}
+	if !pq.down(item.index) {
+		pq.up(item.index)
+	}
+	return true
+}
+
+// Update - sets the value of item and moves it to its new place in the queue.
+// It returns false if item is not in the queue.
+func (pq *PriorityQueue[T]) Update(item *Item[T], value T) bool {
+	if !pq.owns(item) {
+		return false
+	}
+	item.Value = value
+	return pq.Fix(item)
+}
+
+// Remove - removes item from the queue and returns its value, false if item is not in the queue.
+func (pq *PriorityQueue[T]) Remove(item *Item[T]) (T, bool) {
+	if !pq.owns(item) {
+		var empty T
+		return empty, false
+	}
+	return pq.removeAt(item.index), true
+}
+
+// owns reports whether item is currently in the queue.
+func (pq *PriorityQueue[T]) owns(item *Item[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(pq.items) && pq.items[item.index] == item
+}
+
+// removeAt removes the item at index i and returns its value.
+func (pq *PriorityQueue[T]) removeAt(i int) T {
+	last := len(pq.items) - 1
+	item := pq.items[i]
+	if i != last {
+		pq.swap(i, last)
+	}
+	pq.items[last] = nil
+	pq.items = pq.items[:last]
+	if i != last && !pq.down(i) {
+		pq.up(i)
+	}
+	item.index = -1
+	return item.Value
+}
+
+// up sifts the item at index i up towards the root.
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.items[i].Value, pq.items[parent].Value) {
+			break
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+// down sifts the item at index i down towards the leaves and reports whether it moved.
+func (pq *PriorityQueue[T]) down(i int) bool {
+	start := i
+	n := len(pq.items)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && pq.less(pq.items[right].Value, pq.items[child].Value) {
+			child = right
+		}
+		if !pq.less(pq.items[child].Value, pq.items[i].Value) {
+			break
+		}
+		pq.swap(i, child)
+		i = child
+	}
+	return i > start
+}
+
+// swap swaps the items at indexes i and j and keeps their handles in sync.
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
@@ -0,0 +1,222 @@
+package priorityqueue
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// check fails unless every handle knows its index and no item is served after one of its children.
+func check[T any](t *testing.T, pq *PriorityQueue[T]) {
+	t.Helper()
+	for i, item := range pq.items {
+		if item.index != i {
+			t.Fatalf("item at %d has index %d", i, item.index)
+		}
+		if i > 0 && pq.less(item.Value, pq.items[(i-1)/2].Value) {
+			t.Fatalf("item at %d is served before its parent", i)
+		}
+	}
+}
+
+// drain pops every value of pq.
+func drain[T any](pq *PriorityQueue[T]) []T {
+	var values []T
+	for v, ok := pq.Pop(); ok; v, ok = pq.Pop() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestPopOrder(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = r.IntN(50) - 25
+	}
+	ascending := slices.Sorted(slices.Values(values))
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	for name, tt := range map[string]struct {
+		pq   *PriorityQueue[int]
+		want []int
+	}{
+		"NewMin": {NewMin[int](), ascending},
+		"NewMax": {NewMax[int](), descending},
+	} {
+		for _, v := range values {
+			tt.pq.Push(v)
+		}
+		check(t, tt.pq)
+		if top, _ := tt.pq.Peek(); top != tt.want[0] || tt.pq.Len() != len(values) {
+			t.Fatalf("%s: Peek() = %d, Len() = %d", name, top, tt.pq.Len())
+		}
+		if got := drain(tt.pq); !slices.Equal(got, tt.want) {
+			t.Fatalf("%s: popped %v, want %v", name, got, tt.want)
+		}
+		if _, ok := tt.pq.Peek(); ok || !tt.pq.IsEmpty() {
+			t.Fatalf("%s: Peek() on a drained queue = true", name)
+		}
+	}
+}
+
+func TestUpdateFix(t *testing.T) {
+	pq := NewMin[int]()
+	items := make([]*Item[int], 10)
+	for i := range items {
+		items[i] = pq.Push(10 * i)
+	}
+	// Move a leaf up to the root and the root down to a leaf.
+	if !pq.Update(items[9], -1) {
+		t.Fatal("Update() of a queued item = false")
+	}
+	check(t, pq)
+	if top, _ := pq.Peek(); top != -1 {
+		t.Fatalf("Peek() = %d after moving 90 up to -1", top)
+	}
+	items[9].Value = 1000
+	if !pq.Fix(items[9]) {
+		t.Fatal("Fix() of a queued item = false")
+	}
+	check(t, pq)
+	if top, _ := pq.Peek(); top != 0 {
+		t.Fatalf("Peek() = %d after moving -1 down to 1000", top)
+	}
+	if !pq.Update(items[4], 45) {
+		t.Fatal("Update() in place = false")
+	}
+	check(t, pq)
+	want := []int{0, 10, 20, 30, 45, 50, 60, 70, 80, 1000}
+	if got := drain(pq); !slices.Equal(got, want) {
+		t.Fatalf("popped %v, want %v", got, want)
+	}
+}
+
+func TestRemoveMiddle(t *testing.T) {
+	pq := NewMin[int]()
+	items := make([]*Item[int], 15)
+	for i := range items {
+		items[i] = pq.Push(i)
+	}
+	// Index 4 is an inner node with children on both sides.
+	middle := pq.items[4]
+	if v, ok := pq.Remove(middle); !ok || v != middle.Value {
+		t.Fatalf("Remove() = %d, %v, want %d", v, ok, middle.Value)
+	}
+	check(t, pq)
+	// Remove the last item too, which does not move anything.
+	if _, ok := pq.Remove(pq.items[pq.Len()-1]); !ok {
+		t.Fatal("Remove() of the last item = false")
+	}
+	check(t, pq)
+	if pq.Len() != 13 {
+		t.Fatalf("Len() = %d, want 13", pq.Len())
+	}
+	if got := drain(pq); !slices.IsSorted(got) || slices.Contains(got, middle.Value) {
+		t.Fatalf("popped %v, want sorted values without %d", got, middle.Value)
+	}
+}
+
+func TestForeignHandle(t *testing.T) {
+	pq, other := NewMin[int](), NewMin[int]()
+	pq.Push(1)
+	pq.Push(2)
+	// foreign sits at index 0 of other, a valid index of pq too.
+	foreign := other.Push(0)
+	if pq.Update(foreign, -1) || pq.Fix(foreign) {
+		t.Fatal("a handle of another queue was accepted")
+	}
+	if _, ok := pq.Remove(foreign); ok {
+		t.Fatal("Remove() of a handle of another queue = true")
+	}
+	if pq.Update(nil, 0) || pq.Fix(nil) {
+		t.Fatal("a nil handle was accepted")
+	}
+	if foreign.Value != 0 || other.Len() != 1 {
+		t.Fatal("a rejected call changed the foreign handle or its queue")
+	}
+	if got := drain(pq); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("popped %v, want [1 2]", got)
+	}
+}
+
+func TestRemovedHandle(t *testing.T) {
+	pq := NewMin[int]()
+	popped := pq.Push(1)
+	removed := pq.Push(2)
+	cleared := pq.Push(3)
+	pq.Pop()
+	pq.Remove(removed)
+	pq.Clear()
+	for _, item := range []*Item[int]{popped, removed, cleared} {
+		if pq.Update(item, 0) || pq.Fix(item) {
+			t.Fatalf("a removed handle of %d was accepted", item.Value)
+		}
+		if _, ok := pq.Remove(item); ok {
+			t.Fatalf("Remove() of a removed handle of %d = true", item.Value)
+		}
+	}
+	// A new item at the old index of a removed handle must not make the handle valid again.
+	pq.Push(4)
+	if pq.Update(popped, 0) {
+		t.Fatal("a removed handle was accepted after its index was reused")
+	}
+	if top, _ := pq.Peek(); top != 4 || pq.Len() != 1 {
+		t.Fatalf("Peek() = %d, Len() = %d, want 4, 1", top, pq.Len())
+	}
+}
+
+// TestRandomized mixes every operation and checks the queue against a sorted slice of its values.
+func TestRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	pq := NewMin[int]()
+	var handles []*Item[int]
+	var want []int
+	remove := func(v int) {
+		i, _ := slices.BinarySearch(want, v)
+		want = slices.Delete(want, i, i+1)
+	}
+	for step := range 5000 {
+		switch op := r.IntN(10); {
+		case op < 4:
+			v := r.IntN(1000)
+			handles = append(handles, pq.Push(v))
+			i, _ := slices.BinarySearch(want, v)
+			want = slices.Insert(want, i, v)
+		case op < 6:
+			v, ok := pq.Pop()
+			if ok != (len(want) > 0) || ok && v != want[0] {
+				t.Fatalf("step %d: Pop() = %d, %v, want %v", step, v, ok, want[:min(1, len(want))])
+			}
+			if ok {
+				want = want[1:]
+			}
+		case op < 8 && len(handles) > 0:
+			item := handles[r.IntN(len(handles))]
+			old, v := item.Value, r.IntN(1000)
+			if pq.Update(item, v) {
+				remove(old)
+				i, _ := slices.BinarySearch(want, v)
+				want = slices.Insert(want, i, v)
+			}
+		case len(handles) > 0:
+			i := r.IntN(len(handles))
+			item := handles[i]
+			if v, ok := pq.Remove(item); ok {
+				remove(v)
+			}
+			handles = slices.Delete(handles, i, i+1)
+		}
+		check(t, pq)
+		if pq.Len() != len(want) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, pq.Len(), len(want))
+		}
+		if top, ok := pq.Peek(); ok && top != want[0] {
+			t.Fatalf("step %d: Peek() = %d, want %d", step, top, want[0])
+		}
+	}
+	if got := drain(pq); !slices.Equal(got, want) {
+		t.Fatalf("popped %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,203 @@
+package queue
+
+import (
+	"context"
+	"sync"
+)
+
+// Blocking is a bounded Queue that is safe for concurrent use and applies backpressure:
+// Put waits while the queue is full and Take waits while it is empty.
+// Both give up when their context is cancelled or its deadline passes.
+//
+// Once closed, Put fails with ErrClosed while Take keeps returning the remaining
+// items and only fails with ErrClosed once the queue is drained.
+type Blocking[T any] struct {
+	mu       sync.Mutex
+	q        Queue[T]
+	capacity int
+	closed   bool
+
+	// notEmpty and notFull are closed to wake up waiting Take and Put calls.
+	// They are created lazily by the first waiter, so nothing is allocated when nobody waits.
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// NewBlocking returns a new Blocking queue that holds at most capacity items.
+// A capacity < 1 is treated as 1.
+func NewBlocking[T any](capacity int) *Blocking[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &Blocking[T]{capacity: capacity}
+}
+
+// Put - adds an item to the back of the queue, waiting while the queue is full.
+// It returns ErrClosed if the queue is closed, or the context error if ctx is done first.
+func (b *Blocking[T]) Put(ctx context.Context, item T) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			return ErrClosed
+		}
+		if b.q.Size() < b.capacity {
+			b.q.Enqueue(item)
+			signal(&b.notEmpty)
+			b.mu.Unlock()
+			return nil
+		}
+		ready := waitOn(&b.notFull)
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ready:
+		}
+	}
+}
+
+// Take - removes and returns the item at the front of the queue, waiting while the queue is empty.
+// It returns ErrClosed if the queue is closed and drained, or the context error if ctx is done first.
+func (b *Blocking[T]) Take(ctx context.Context) (T, error) {
+	var empty T
+	for {
+		if err := ctx.Err(); err != nil {
+			return empty, err
+		}
+		b.mu.Lock()
+		if item, ok := b.q.Dequeue(); ok {
+			signal(&b.notFull)
+			b.mu.Unlock()
+			return item, nil
+		}
+		if b.closed {
+			b.mu.Unlock()
+			return empty, ErrClosed
+		}
+		ready := waitOn(&b.notEmpty)
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return empty, ctx.Err()
+		case <-ready:
+		}
+	}
+}
+
+// TryPut - adds an item to the back of the queue without waiting.
+// It returns ErrFull if the queue is full and ErrClosed if it is closed.
+func (b *Blocking[T]) TryPut(item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrClosed
+	}
+	if b.q.Size() >= b.capacity {
+		return ErrFull
+	}
+	b.q.Enqueue(item)
+	signal(&b.notEmpty)
+	return nil
+}
+
+// TryTake - removes and returns the item at the front of the queue without waiting.
+// It returns ErrEmpty if the queue is empty and ErrClosed if it is closed and drained.
+func (b *Blocking[T]) TryTake() (T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if item, ok := b.q.Dequeue(); ok {
+		signal(&b.notFull)
+		return item, nil
+	}
+	var empty T
+	if b.closed {
+		return empty, ErrClosed
+	}
+	return empty, ErrEmpty
+}
+
+// Close - closes the queue. Waiting Put calls fail with ErrClosed and waiting Take calls
+// drain the remaining items. Closing an already closed queue does nothing.
+func (b *Blocking[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	signal(&b.notEmpty)
+	signal(&b.notFull)
+}
+
+// IsClosed - returns true if the queue has been closed.
+func (b *Blocking[T]) IsClosed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closed
+}
+
+// Size - returns the number of items in the queue.
+func (b *Blocking[T]) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.q.Size()
+}
+
+// Cap - returns the maximum number of items the queue holds.
+func (b *Blocking[T]) Cap() int {
+	return b.capacity
+}
+
+// Chan - returns a channel that receives the items of the queue in order.
+// The channel is closed once the queue is closed and drained, or when ctx is done. An item already
+// taken from the queue but not yet received when ctx is done goes back to the front of the queue,
+// so no item is lost; the queue may then briefly hold one item more than its capacity.
+func (b *Blocking[T]) Chan(ctx context.Context) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			item, err := b.Take(ctx)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				b.putBack(item)
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// putBack returns an item taken by Take to the front of the queue, closed or not.
+func (b *Blocking[T]) putBack(item T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.q.pushFront(item)
+	signal(&b.notEmpty)
+}
+
+// waitOn returns the channel to wait on for the condition ch stands for, creating it if needed.
+func waitOn(ch *chan struct{}) <-chan struct{} {
+	if *ch == nil {
+		*ch = make(chan struct{})
+	}
+	return *ch
+}
+
+// signal wakes up everyone waiting on ch.
+func signal(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
@@ -0,0 +1,280 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// settle is how long a test waits to conclude that a call is blocked.
+const settle = 20 * time.Millisecond
+
+// async runs f in a goroutine and returns a channel that receives its error.
+func async(f func() error) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- f() }()
+	return done
+}
+
+func expectBlocked(t *testing.T, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("call returned %v, want it to block", err)
+	case <-time.After(settle):
+	}
+}
+
+func expectDone(t *testing.T, done <-chan error, want error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		if !errors.Is(err, want) {
+			t.Fatalf("call returned %v, want %v", err, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("call is still blocked")
+	}
+}
+
+func TestBlockingPutWaitsForTake(t *testing.T) {
+	b := NewBlocking[int](1)
+	ctx := context.Background()
+	if err := b.Put(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	put := async(func() error { return b.Put(ctx, 2) })
+	expectBlocked(t, put)
+	if v, err := b.Take(ctx); err != nil || v != 1 {
+		t.Fatalf("Take() = %d, %v, want 1", v, err)
+	}
+	expectDone(t, put, nil)
+	if v, err := b.Take(ctx); err != nil || v != 2 {
+		t.Fatalf("Take() = %d, %v, want 2", v, err)
+	}
+}
+
+func TestBlockingTakeWaitsForPut(t *testing.T) {
+	b := NewBlocking[int](1)
+	ctx := context.Background()
+	got := make(chan int, 1)
+	take := async(func() error {
+		v, err := b.Take(ctx)
+		got <- v
+		return err
+	})
+	expectBlocked(t, take)
+	b.Put(ctx, 7)
+	expectDone(t, take, nil)
+	if v := <-got; v != 7 {
+		t.Fatalf("Take() = %d, want 7", v)
+	}
+}
+
+func TestBlockingContext(t *testing.T) {
+	full := NewBlocking[int](1)
+	full.TryPut(0)
+	empty := NewBlocking[int](1)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithTimeout(context.Background(), settle)
+	defer cancelExpired()
+	for _, tt := range []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"cancelled", cancelled, context.Canceled},
+		{"deadline", expired, context.DeadlineExceeded},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			expectDone(t, async(func() error { return full.Put(tt.ctx, 1) }), tt.want)
+			expectDone(t, async(func() error { _, err := empty.Take(tt.ctx); return err }), tt.want)
+		})
+	}
+	if full.Size() != 1 || empty.Size() != 0 {
+		t.Fatalf("Size() = %d, %d after failed calls, want 1, 0", full.Size(), empty.Size())
+	}
+
+	// Cancelling wakes calls that are already waiting.
+	ctx, cancel := context.WithCancel(context.Background())
+	put := async(func() error { return full.Put(ctx, 1) })
+	take := async(func() error { _, err := empty.Take(ctx); return err })
+	expectBlocked(t, put)
+	expectBlocked(t, take)
+	cancel()
+	expectDone(t, put, context.Canceled)
+	expectDone(t, take, context.Canceled)
+}
+
+func TestBlockingClose(t *testing.T) {
+	ctx := context.Background()
+	full, empty := NewBlocking[int](2), NewBlocking[int](2)
+	full.Put(ctx, 1)
+	full.Put(ctx, 2)
+
+	var puts, takes []<-chan error
+	for i := range goroutines {
+		puts = append(puts, async(func() error { return full.Put(ctx, 10+i) }))
+		takes = append(takes, async(func() error { _, err := empty.Take(ctx); return err }))
+	}
+	for i := range goroutines {
+		expectBlocked(t, puts[i])
+		expectBlocked(t, takes[i])
+	}
+	full.Close()
+	empty.Close()
+	full.Close()
+	for i := range goroutines {
+		expectDone(t, puts[i], ErrClosed)
+		expectDone(t, takes[i], ErrClosed)
+	}
+
+	// A closed queue still drains the items it holds.
+	if !full.IsClosed() {
+		t.Fatal("IsClosed() = false after Close")
+	}
+	for _, want := range []int{1, 2} {
+		if v, err := full.Take(ctx); err != nil || v != want {
+			t.Fatalf("Take() after Close = %d, %v, want %d", v, err, want)
+		}
+	}
+	if _, err := full.Take(ctx); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Take() on a drained closed queue = %v, want ErrClosed", err)
+	}
+	if err := full.Put(ctx, 3); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Put() on a closed queue = %v, want ErrClosed", err)
+	}
+}
+
+func TestBlockingTry(t *testing.T) {
+	b := NewBlocking[int](0)
+	if b.Cap() != 1 {
+		t.Fatalf("Cap() = %d for capacity 0, want 1", b.Cap())
+	}
+	b = NewBlocking[int](2)
+	if _, err := b.TryTake(); !errors.Is(err, ErrEmpty) {
+		t.Fatalf("TryTake() on an empty queue = %v, want ErrEmpty", err)
+	}
+	for i := range 2 {
+		if err := b.TryPut(i); err != nil {
+			t.Fatalf("TryPut(%d) = %v", i, err)
+		}
+	}
+	if err := b.TryPut(2); !errors.Is(err, ErrFull) {
+		t.Fatalf("TryPut() on a full queue = %v, want ErrFull", err)
+	}
+	if v, err := b.TryTake(); err != nil || v != 0 {
+		t.Fatalf("TryTake() = %d, %v, want 0", v, err)
+	}
+	b.Close()
+	if err := b.TryPut(3); !errors.Is(err, ErrClosed) {
+		t.Fatalf("TryPut() on a closed queue = %v, want ErrClosed", err)
+	}
+	if v, err := b.TryTake(); err != nil || v != 1 {
+		t.Fatalf("TryTake() after Close = %d, %v, want 1", v, err)
+	}
+	if _, err := b.TryTake(); !errors.Is(err, ErrClosed) {
+		t.Fatalf("TryTake() on a drained closed queue = %v, want ErrClosed", err)
+	}
+}
+
+func TestBlockingChanDrains(t *testing.T) {
+	b := NewBlocking[int](perWorker)
+	for i := range perWorker {
+		b.TryPut(i)
+	}
+	b.Close()
+	var got []int
+	for v := range b.Chan(context.Background()) {
+		got = append(got, v)
+	}
+	if len(got) != perWorker || !slices.IsSorted(got) {
+		t.Fatalf("Chan() received %d items out of order, want %d in order", len(got), perWorker)
+	}
+}
+
+// TestBlockingChanCancel cancels Chan while its goroutine holds an item it took but could not deliver:
+// the item must end up either received or back at the front of the queue.
+func TestBlockingChanCancel(t *testing.T) {
+	for range 100 {
+		b := NewBlocking[int](3)
+		for i := range 3 {
+			b.TryPut(i)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := b.Chan(ctx)
+		got := []int{<-ch}
+		// Wait for the goroutine to take the next item.
+		for b.Size() != 1 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+		for v := range ch {
+			got = append(got, v)
+		}
+		for {
+			v, err := b.TryTake()
+			if err != nil {
+				break
+			}
+			got = append(got, v)
+		}
+		if !slices.Equal(got, []int{0, 1, 2}) {
+			t.Fatalf("received and left items = %v, want [0 1 2]", got)
+		}
+	}
+}
+
+func TestBlockingProducersConsumers(t *testing.T) {
+	b := NewBlocking[int](4)
+	ctx := context.Background()
+	var producers, consumers sync.WaitGroup
+	results := make(chan []int, goroutines)
+	for g := range goroutines {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := range perWorker {
+				if err := b.Put(ctx, g*perWorker+i); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			var got []int
+			for {
+				v, err := b.Take(ctx)
+				if err != nil {
+					results <- got
+					return
+				}
+				got = append(got, v)
+			}
+		}()
+	}
+	producers.Wait()
+	b.Close()
+	consumers.Wait()
+	close(results)
+	var all []int
+	for got := range results {
+		all = append(all, got...)
+	}
+	slices.Sort(all)
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("item %d is %d, every item must be taken exactly once", i, v)
+		}
+	}
+	if len(all) != goroutines*perWorker {
+		t.Fatalf("took %d items, want %d", len(all), goroutines*perWorker)
+	}
+}
@@ -0,0 +1,188 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// FullPolicy decides what a CircularQueue does when an item is added while it is full.
+type FullPolicy int
+
+const (
+	// RejectWhenFull makes Enqueue fail with ErrFull ("overflow").
+	RejectWhenFull FullPolicy = iota
+	// OverwriteOldest makes Enqueue drop the item at the front to make room.
+	OverwriteOldest
+	// BlockWhenFull makes Enqueue wait until a Dequeue makes room.
+	BlockWhenFull
+)
+
+// CircularQueue is a Circular Queue with a fixed capacity, safe for concurrent use.
+// Its buffer is allocated once by NewCircularQueue, so adding and removing items never allocates.
+type CircularQueue[T any] struct {
+	mu     sync.Mutex
+	items  []T
+	head   int
+	size   int
+	policy FullPolicy
+
+	// notFull is closed to wake up Enqueue calls waiting under BlockWhenFull.
+	notFull chan struct{}
+}
+
+// NewCircularQueue returns a new CircularQueue that holds at most capacity items and
+// applies policy when it is full. A capacity < 1 is treated as 1.
+func NewCircularQueue[T any](capacity int, policy FullPolicy) *CircularQueue[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &CircularQueue[T]{items: make([]T, capacity), policy: policy}
+}
+
+// Enqueue - adds an item to the back of the queue, applying the full policy if there is no room.
+// Under BlockWhenFull it waits as long as it takes; use EnqueueContext to give up earlier.
+func (c *CircularQueue[T]) Enqueue(item T) error {
+	return c.EnqueueContext(context.Background(), item)
+}
+
+// EnqueueContext - like Enqueue, but a wait under BlockWhenFull ends with the context error once ctx is done.
+func (c *CircularQueue[T]) EnqueueContext(ctx context.Context, item T) error {
+	for {
+		c.mu.Lock()
+		if c.size < len(c.items) {
+			c.push(item)
+			c.mu.Unlock()
+			return nil
+		}
+		switch c.policy {
+		case OverwriteOldest:
+			c.pop()
+			c.push(item)
+			c.mu.Unlock()
+			return nil
+		case BlockWhenFull:
+			ready := waitOn(&c.notFull)
+			c.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ready:
+			}
+		default:
+			c.mu.Unlock()
+			return ErrFull
+		}
+	}
+}
+
+// Dequeue - removes and returns the item at the front of the queue, false if the queue is empty.
+func (c *CircularQueue[T]) Dequeue() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.size == 0 {
+		var empty T
+		return empty, false
+	}
+	item := c.pop()
+	signal(&c.notFull)
+	return item, true
+}
+
+// Peek - returns the item at the front of the queue without removing it, false if the queue is empty.
+func (c *CircularQueue[T]) Peek() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.size == 0 {
+		var empty T
+		return empty, false
+	}
+	return c.items[c.head], true
+}
+
+// Items - returns a snapshot of the items from front to back.
+func (c *CircularQueue[T]) Items() []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return unwrap(c.items, c.head, c.size, c.size)
+}
+
+// All - returns an iterator over a snapshot of the items from front to back.
+// The queue may be modified during the iteration.
+func (c *CircularQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range c.Items() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// String - returns a string representation of the queue, front first.
+func (c *CircularQueue[T]) String() string {
+	return fmt.Sprint(c.Items())
+}
+
+// Format - implements fmt.Formatter: %v prints the items front first, %+v adds the size and capacity
+// and %#v prints them as a Go slice literal.
+func (c *CircularQueue[T]) Format(f fmt.State, verb rune) {
+	items := c.Items()
+	format(f, verb, items, len(items), len(c.items))
+}
+
+// IsEmpty - returns true if the queue is empty.
+func (c *CircularQueue[T]) IsEmpty() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size == 0
+}
+
+// IsFull - returns true if the queue holds as many items as its capacity.
+func (c *CircularQueue[T]) IsFull() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size == len(c.items)
+}
+
+// Size - returns the number of items in the queue.
+func (c *CircularQueue[T]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Cap - returns the maximum number of items the queue holds.
+func (c *CircularQueue[T]) Cap() int {
+	return len(c.items)
+}
+
+// Clear - removes all items from the queue, keeping its buffer.
+func (c *CircularQueue[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var empty T
+	for i := range c.items {
+		c.items[i] = empty
+	}
+	c.head = 0
+	c.size = 0
+	signal(&c.notFull)
+}
+
+// push adds an item at the back; the caller makes sure there is room.
+func (c *CircularQueue[T]) push(item T) {
+	c.items[(c.head+c.size)%len(c.items)] = item
+	c.size++
+}
+
+// pop removes the item at the front; the caller makes sure there is one.
+func (c *CircularQueue[T]) pop() T {
+	var empty T
+	item := c.items[c.head]
+	c.items[c.head] = empty
+	c.head = (c.head + 1) % len(c.items)
+	c.size--
+	return item
+}
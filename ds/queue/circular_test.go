@@ -0,0 +1,129 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCircularReject(t *testing.T) {
+	c := NewCircularQueue[int](3, RejectWhenFull)
+	for i := range 3 {
+		if err := c.Enqueue(i); err != nil {
+			t.Fatalf("Enqueue(%d) = %v", i, err)
+		}
+	}
+	if !c.IsFull() {
+		t.Fatal("IsFull() = false with 3 items of 3")
+	}
+	if err := c.Enqueue(3); !errors.Is(err, ErrFull) {
+		t.Fatalf("Enqueue() on a full queue = %v, want ErrFull", err)
+	}
+	if got := c.Items(); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("Items() = %v after a rejected Enqueue", got)
+	}
+	c.Dequeue()
+	if err := c.Enqueue(3); err != nil {
+		t.Fatalf("Enqueue() after Dequeue = %v", err)
+	}
+	if got := c.Items(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("Items() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestCircularOverwrite(t *testing.T) {
+	c := NewCircularQueue[int](4, OverwriteOldest)
+	// 10 items go around the buffer more than twice, each one evicting the oldest.
+	for i := range 10 {
+		if err := c.Enqueue(i); err != nil {
+			t.Fatalf("Enqueue(%d) = %v", i, err)
+		}
+		want := make([]int, 0, 4)
+		for v := max(0, i-3); v <= i; v++ {
+			want = append(want, v)
+		}
+		if got := c.Items(); !slices.Equal(got, want) {
+			t.Fatalf("Items() = %v after Enqueue(%d), want %v", got, i, want)
+		}
+	}
+	for _, want := range []int{6, 7, 8, 9} {
+		if v, ok := c.Dequeue(); !ok || v != want {
+			t.Fatalf("Dequeue() = %d, %v, want %d", v, ok, want)
+		}
+	}
+	if _, ok := c.Dequeue(); ok {
+		t.Fatal("Dequeue() on an empty queue = true")
+	}
+	if _, ok := c.Peek(); ok {
+		t.Fatal("Peek() on an empty queue = true")
+	}
+}
+
+func TestCircularBlock(t *testing.T) {
+	c := NewCircularQueue[int](1, BlockWhenFull)
+	c.Enqueue(1)
+
+	enqueue := async(func() error { return c.Enqueue(2) })
+	expectBlocked(t, enqueue)
+	if v, _ := c.Dequeue(); v != 1 {
+		t.Fatalf("Dequeue() = %d, want 1", v)
+	}
+	expectDone(t, enqueue, nil)
+
+	// Clear makes room too.
+	enqueue = async(func() error { return c.Enqueue(3) })
+	expectBlocked(t, enqueue)
+	c.Clear()
+	expectDone(t, enqueue, nil)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.EnqueueContext(cancelled, 4); !errors.Is(err, context.Canceled) {
+		t.Fatalf("EnqueueContext(cancelled) = %v, want context.Canceled", err)
+	}
+	expired, cancelExpired := context.WithTimeout(context.Background(), settle)
+	defer cancelExpired()
+	if err := c.EnqueueContext(expired, 4); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("EnqueueContext(deadline) = %v, want context.DeadlineExceeded", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	enqueue = async(func() error { return c.EnqueueContext(ctx, 4) })
+	expectBlocked(t, enqueue)
+	cancel()
+	expectDone(t, enqueue, context.Canceled)
+	if got := c.Items(); !slices.Equal(got, []int{3}) {
+		t.Fatalf("Items() = %v, want [3]", got)
+	}
+}
+
+func TestCircularMinCapacity(t *testing.T) {
+	c := NewCircularQueue[int](0, RejectWhenFull)
+	if c.Cap() != 1 {
+		t.Fatalf("Cap() = %d for capacity 0, want 1", c.Cap())
+	}
+}
+
+// TestCircularAllocs checks the steady state of every policy never allocates, once the queue is full
+// for the overwrite policy.
+func TestCircularAllocs(t *testing.T) {
+	for _, policy := range []FullPolicy{RejectWhenFull, OverwriteOldest, BlockWhenFull} {
+		c := NewCircularQueue[int](8, policy)
+		for i := range 4 {
+			c.Enqueue(i)
+		}
+		if n := testing.AllocsPerRun(1000, func() {
+			c.Enqueue(1)
+			c.Dequeue()
+		}); n != 0 {
+			t.Errorf("policy %d: Enqueue and Dequeue allocate %v times, want 0", policy, n)
+		}
+	}
+	c := NewCircularQueue[int](8, OverwriteOldest)
+	for i := range 8 {
+		c.Enqueue(i)
+	}
+	if n := testing.AllocsPerRun(1000, func() { c.Enqueue(1) }); n != 0 {
+		t.Errorf("Enqueue on a full overwriting queue allocates %v times, want 0", n)
+	}
+}
@@ -0,0 +1,85 @@
+package queue
+
+import "sync"
+
+// Concurrent is a Queue that is safe for concurrent use by multiple goroutines.
+// Compound operations such as DequeueN are atomic: no other goroutine can interleave with them.
+type Concurrent[T any] struct {
+	mu sync.RWMutex
+	q  Queue[T]
+}
+
+// NewConcurrent returns a new Concurrent queue.
+func NewConcurrent[T any]() *Concurrent[T] {
+	return &Concurrent[T]{}
+}
+
+// Enqueue - adds an item to the back of the queue.
+func (c *Concurrent[T]) Enqueue(item T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.q.Enqueue(item)
+}
+
+// EnqueueAll - adds all items to the back of the queue, in order and without interleaving.
+func (c *Concurrent[T]) EnqueueAll(items ...T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, item := range items {
+		c.q.Enqueue(item)
+	}
+}
+
+// Dequeue - removes and returns the item at the front of the queue, false if the queue is empty.
+func (c *Concurrent[T]) Dequeue() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.q.Dequeue()
+}
+
+// DequeueN - removes and returns up to n items from the front of the queue.
+// It returns an empty slice if the queue is empty or n <= 0.
+func (c *Concurrent[T]) DequeueN(n int) []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if n > c.q.Size() {
+		n = c.q.Size()
+	}
+	if n <= 0 {
+		return []T{}
+	}
+	items := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		item, _ := c.q.Dequeue()
+		items = append(items, item)
+	}
+	return items
+}
+
+// Peek - returns the item at the front of the queue without removing it, false if the queue is empty.
+func (c *Concurrent[T]) Peek() (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.q.Peek()
+}
+
+// IsEmpty - returns true if the queue is empty.
+func (c *Concurrent[T]) IsEmpty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.q.IsEmpty()
+}
+
+// Size - returns the number of items in the queue.
+func (c *Concurrent[T]) Size() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.q.Size()
+}
+
+// Clear - removes all items from the queue.
+func (c *Concurrent[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.q.Clear()
+}
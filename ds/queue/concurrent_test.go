@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	goroutines = 8
+	perWorker  = 1000
+)
+
+func TestConcurrentZeroValue(t *testing.T) {
+	var c Concurrent[int]
+	c.Enqueue(1)
+	if v, ok := c.Dequeue(); !ok || v != 1 {
+		t.Fatalf("Dequeue() = %d, %v, want 1, true", v, ok)
+	}
+}
+
+func TestConcurrentProducersConsumers(t *testing.T) {
+	c := NewConcurrent[int]()
+	results := make(chan []int, goroutines)
+	var producers, consumers sync.WaitGroup
+	var produced atomic.Bool
+	for g := range goroutines {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := range perWorker / 10 {
+				batch := make([]int, 10)
+				for j := range batch {
+					batch[j] = g*perWorker + i*10 + j
+				}
+				if i%2 == 0 {
+					c.EnqueueAll(batch...)
+				} else {
+					for _, v := range batch {
+						c.Enqueue(v)
+					}
+				}
+			}
+		}()
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			var got []int
+			for {
+				// Read the flag first: once it is set, an empty queue stays empty.
+				last := produced.Load()
+				c.Peek()
+				c.Size()
+				if g%2 == 0 {
+					got = append(got, c.DequeueN(7)...)
+				} else if v, ok := c.Dequeue(); ok {
+					got = append(got, v)
+				}
+				if last && c.IsEmpty() {
+					results <- got
+					return
+				}
+			}
+		}()
+	}
+	producers.Wait()
+	produced.Store(true)
+	consumers.Wait()
+	close(results)
+
+	seen := make(map[int]bool)
+	for got := range results {
+		for _, v := range got {
+			if seen[v] {
+				t.Fatalf("value %d dequeued twice", v)
+			}
+			seen[v] = true
+		}
+	}
+	if len(seen) != goroutines*perWorker {
+		t.Fatalf("dequeued %d values, want %d", len(seen), goroutines*perWorker)
+	}
+}
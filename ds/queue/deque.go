@@ -0,0 +1,239 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Deque is a Double Ended Queue backed by a circular buffer.
+// Items can be pushed and popped at both ends in amortized O(1) and any item can be read by its index in O(1).
+// Like Queue, the buffer doubles when full, halves when at most a quarter full, and vacated slots are zeroed.
+type Deque[T any] struct {
+	items []T
+	head  int
+	size  int
+}
+
+// NewDeque returns a new Deque.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// PushFront - adds an item to the front of the deque.
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+	d.head = d.index(-1)
+	d.items[d.head] = item
+	d.size++
+}
+
+// PushBack - adds an item to the back of the deque.
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+	d.items[d.index(d.size)] = item
+	d.size++
+}
+
+// PopFront - removes and returns the item at the front of the deque, false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var empty T
+	if d.size == 0 {
+		return empty, false
+	}
+	item := d.items[d.head]
+	d.items[d.head] = empty
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return item, true
+}
+
+// PopBack - removes and returns the item at the back of the deque, false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var empty T
+	if d.size == 0 {
+		return empty, false
+	}
+	i := d.index(d.size - 1)
+	item := d.items[i]
+	d.items[i] = empty
+	d.size--
+	d.shrink()
+	return item, true
+}
+
+// Front - returns the item at the front of the deque without removing it, false if the deque is empty.
+func (d *Deque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var empty T
+		return empty, false
+	}
+	return d.items[d.head], true
+}
+
+// Back - returns the item at the back of the deque without removing it, false if the deque is empty.
+func (d *Deque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var empty T
+		return empty, false
+	}
+	return d.items[d.index(d.size-1)], true
+}
+
+// At - returns the item at index i, counting from the front, or ErrIndexOutOfRange.
+func (d *Deque[T]) At(i int) (T, error) {
+	if i < 0 || i >= d.size {
+		var empty T
+		return empty, ErrIndexOutOfRange
+	}
+	return d.items[d.index(i)], nil
+}
+
+// Set - replaces the item at index i, counting from the front, or returns ErrIndexOutOfRange.
+func (d *Deque[T]) Set(i int, item T) error {
+	if i < 0 || i >= d.size {
+		return ErrIndexOutOfRange
+	}
+	d.items[d.index(i)] = item
+	return nil
+}
+
+// Rotate - rotates the deque n steps to the back: the last n items move to the front.
+// A negative n rotates to the front instead: the first -n items move to the back.
+func (d *Deque[T]) Rotate(n int) {
+	if d.size <= 1 {
+		return
+	}
+	n %= d.size
+	if n < 0 {
+		n += d.size
+	}
+	if n == 0 {
+		return
+	}
+	if d.size == len(d.items) {
+		d.head = d.index(d.size - n)
+		return
+	}
+
+	// The buffer has free slots, so move the fewest items across the gap one by one.
+	var empty T
+	if n <= d.size/2 {
+		for ; n > 0; n-- {
+			d.head = d.index(-1)
+			back := d.index(d.size)
+			d.items[d.head], d.items[back] = d.items[back], empty
+		}
+		return
+	}
+	for n = d.size - n; n > 0; n-- {
+		back := d.index(d.size)
+		d.items[back], d.items[d.head] = d.items[d.head], empty
+		d.head = d.index(1)
+	}
+}
+
+// ForEach - calls f for each item from front to back.
+func (d *Deque[T]) ForEach(f func(T)) {
+	for i := 0; i < d.size; i++ {
+		f(d.items[d.index(i)])
+	}
+}
+
+// ForEachBackward - calls f for each item from back to front.
+func (d *Deque[T]) ForEachBackward(f func(T)) {
+	for i := d.size - 1; i >= 0; i-- {
+		f(d.items[d.index(i)])
+	}
+}
+
+// All - returns an iterator over the items from front to back.
+// The deque must not be modified during the iteration.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.items[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate - returns an iterator over the indexes and items from front to back.
+// The deque must not be modified during the iteration.
+func (d *Deque[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(i, d.items[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns an iterator over the items from back to front.
+// The deque must not be modified during the iteration.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.items[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// String - returns a string representation of the deque, front first.
+func (d *Deque[T]) String() string {
+	return fmt.Sprint(unwrap(d.items, d.head, d.size, d.size))
+}
+
+// Format - implements fmt.Formatter: %v prints the items front first, %+v adds the size and capacity
+// and %#v prints them as a Go slice literal.
+func (d *Deque[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, unwrap(d.items, d.head, d.size, d.size), d.size, len(d.items))
+}
+
+// IsEmpty - returns true if the deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size - returns the number of items in the deque.
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// Clear - removes all items from the deque.
+func (d *Deque[T]) Clear() {
+	d.items = nil
+	d.head = 0
+	d.size = 0
+}
+
+// index returns the position in the buffer of the item at index i, counting from the front.
+// i may be -1 or size, the free slots right before the front and after the back.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i + len(d.items)) % len(d.items)
+}
+
+// grow makes room for one more item.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.items) {
+		return
+	}
+	n := 2 * len(d.items)
+	if n < minCapacity {
+		n = minCapacity
+	}
+	d.items = unwrap(d.items, d.head, d.size, n)
+	d.head = 0
+}
+
+// shrink halves the buffer once it is at most a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.items) > minCapacity && d.size <= len(d.items)/4 {
+		d.items = unwrap(d.items, d.head, d.size, len(d.items)/2)
+		d.head = 0
+	}
+}
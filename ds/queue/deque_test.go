@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkDeque fails unless d holds want from front to back, through every accessor.
+func checkDeque(t *testing.T, d *Deque[int], want []int) {
+	t.Helper()
+	if got := slices.Collect(d.All()); !slices.Equal(got, want) {
+		t.Fatalf("All() = %v, want %v", got, want)
+	}
+	backward := slices.Clone(want)
+	slices.Reverse(backward)
+	if got := slices.Collect(d.Backward()); !slices.Equal(got, backward) {
+		t.Fatalf("Backward() = %v, want %v", got, backward)
+	}
+	for i, v := range want {
+		if got, err := d.At(i); err != nil || got != v {
+			t.Fatalf("At(%d) = %d, %v, want %d", i, got, err, v)
+		}
+	}
+	front, okFront := d.Front()
+	back, okBack := d.Back()
+	if d.Size() != len(want) || okFront != (len(want) > 0) || okBack != okFront ||
+		okFront && (front != want[0] || back != want[len(want)-1]) {
+		t.Fatalf("Size(), Front(), Back() = %d, %d, %d for %v", d.Size(), front, back, want)
+	}
+}
+
+// rotated is the reference for Rotate: the last n values move to the front.
+func rotated(values []int, n int) []int {
+	if len(values) == 0 {
+		return values
+	}
+	n = ((n % len(values)) + len(values)) % len(values)
+	return append(slices.Clone(values[len(values)-n:]), values[:len(values)-n]...)
+}
+
+func TestDequeWrapAndGrowth(t *testing.T) {
+	d := NewDeque[int]()
+	var want []int
+	// Alternate the ends so the front wraps below index 0 right away, then grow through several sizes.
+	for i := range 100 {
+		if i%2 == 0 {
+			d.PushFront(i)
+			want = slices.Insert(want, 0, i)
+		} else {
+			d.PushBack(i)
+			want = append(want, i)
+		}
+		checkDeque(t, d, want)
+	}
+	for len(want) > 0 {
+		if len(want)%3 == 0 {
+			v, ok := d.PopBack()
+			if !ok || v != want[len(want)-1] {
+				t.Fatalf("PopBack() = %d, %v, want %d", v, ok, want[len(want)-1])
+			}
+			want = want[:len(want)-1]
+		} else {
+			v, ok := d.PopFront()
+			if !ok || v != want[0] {
+				t.Fatalf("PopFront() = %d, %v, want %d", v, ok, want[0])
+			}
+			want = want[1:]
+		}
+		checkDeque(t, d, want)
+	}
+	if _, ok := d.PopFront(); ok {
+		t.Fatal("PopFront() on an empty deque = true")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Fatal("PopBack() on an empty deque = true")
+	}
+}
+
+func TestDequeBounds(t *testing.T) {
+	d := NewDeque[int]()
+	if _, err := d.At(0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("At(0) on an empty deque = %v, want ErrIndexOutOfRange", err)
+	}
+	for i := range 3 {
+		d.PushBack(i)
+	}
+	for _, i := range []int{-1, 3, 100} {
+		if _, err := d.At(i); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("At(%d) = %v, want ErrIndexOutOfRange", i, err)
+		}
+		if err := d.Set(i, 9); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Set(%d) = %v, want ErrIndexOutOfRange", i, err)
+		}
+	}
+	if err := d.Set(2, 9); err != nil {
+		t.Fatalf("Set(2) = %v", err)
+	}
+	checkDeque(t, d, []int{0, 1, 9})
+}
+
+func TestDequeRotate(t *testing.T) {
+	for _, size := range []int{0, 1, 5, 8} {
+		for _, n := range []int{0, 1, 2, 4, -1, -3, size, size + 2, -size - 1, 3 * size} {
+			// Size 8 fills the buffer, which rotates by moving the head only.
+			t.Run(fmt.Sprintf("size=%d/n=%d", size, n), func(t *testing.T) {
+				d := NewDeque[int]()
+				var want []int
+				for i := range size {
+					d.PushBack(i)
+					want = append(want, i)
+				}
+				// Start away from index 0 so the rotation crosses the wrap.
+				if size > 1 {
+					v, _ := d.PopFront()
+					d.PushBack(v)
+					want = append(want[1:], want[0])
+				}
+				d.Rotate(n)
+				checkDeque(t, d, rotated(want, n))
+			})
+		}
+	}
+}
+
+// TestDequeRandomized mixes every operation and checks the deque against a plain slice.
+func TestDequeRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	d := NewDeque[int]()
+	var want []int
+	for step := range 5000 {
+		switch r.IntN(6) {
+		case 0:
+			d.PushFront(step)
+			want = slices.Insert(want, 0, step)
+		case 1:
+			d.PushBack(step)
+			want = append(want, step)
+		case 2:
+			if _, ok := d.PopFront(); ok {
+				want = want[1:]
+			}
+		case 3:
+			if _, ok := d.PopBack(); ok {
+				want = want[:len(want)-1]
+			}
+		case 4:
+			n := r.IntN(21) - 10
+			d.Rotate(n)
+			want = rotated(want, n)
+		case 5:
+			if len(want) > 0 {
+				i := r.IntN(len(want))
+				d.Set(i, -step)
+				want[i] = -step
+			}
+		}
+		checkDeque(t, d, want)
+	}
+}
@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"encoding/json"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/gobslice"
+)
+
+// Queue, Deque and CircularQueue encode as their items from front to back: a JSON array for encoding/json
+// and a gob encoded slice for encoding.BinaryMarshaler, which encoding/gob also uses to encode them.
+// The capacity and policy of a CircularQueue are not encoded; decoding keeps those of the receiver and
+// fails with ErrFull if the items do not fit. A zero CircularQueue gets a capacity of len(items).
+
+// MarshalJSON - encodes the queue as a JSON array, front first.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.values())
+}
+
+// UnmarshalJSON - replaces the items of the queue with a JSON array, front first.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	q.load(items)
+	return nil
+}
+
+// MarshalBinary - encodes the queue with encoding/gob, front first.
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(q.values())
+}
+
+// UnmarshalBinary - replaces the items of the queue with items encoded by MarshalBinary.
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	items, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	q.load(items)
+	return nil
+}
+
+// MarshalJSON - encodes the deque as a JSON array, front first.
+func (d *Deque[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(unwrap(d.items, d.head, d.size, d.size))
+}
+
+// UnmarshalJSON - replaces the items of the deque with a JSON array, front first.
+func (d *Deque[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	d.load(items)
+	return nil
+}
+
+// MarshalBinary - encodes the deque with encoding/gob, front first.
+func (d *Deque[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(unwrap(d.items, d.head, d.size, d.size))
+}
+
+// UnmarshalBinary - replaces the items of the deque with items encoded by MarshalBinary.
+func (d *Deque[T]) UnmarshalBinary(data []byte) error {
+	items, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	d.load(items)
+	return nil
+}
+
+// MarshalJSON - encodes the circular queue as a JSON array, front first.
+func (c *CircularQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Items())
+}
+
+// UnmarshalJSON - replaces the items of the circular queue with a JSON array, front first.
+func (c *CircularQueue[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return c.load(items)
+}
+
+// MarshalBinary - encodes the circular queue with encoding/gob, front first.
+func (c *CircularQueue[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(c.Items())
+}
+
+// UnmarshalBinary - replaces the items of the circular queue with items encoded by MarshalBinary.
+func (c *CircularQueue[T]) UnmarshalBinary(data []byte) error {
+	items, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	return c.load(items)
+}
+
+// load replaces the items of the queue.
+func (q *Queue[T]) load(items []T) {
+	q.Clear()
+	for _, item := range items {
+		q.Enqueue(item)
+	}
+}
+
+// load replaces the items of the deque.
+func (d *Deque[T]) load(items []T) {
+	d.Clear()
+	for _, item := range items {
+		d.PushBack(item)
+	}
+}
+
+// load replaces the items of the circular queue, leaving it unchanged if they do not fit.
+func (c *CircularQueue[T]) load(items []T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.items) == 0 {
+		c.items = make([]T, max(len(items), 1))
+	}
+	if len(items) > len(c.items) {
+		return ErrFull
+	}
+	clear(c.items)
+	copy(c.items, items)
+	c.head = 0
+	c.size = len(items)
+	signal(&c.notFull)
+	return nil
+}
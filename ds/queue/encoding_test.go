@@ -0,0 +1,67 @@
+package queue
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/codectest"
+)
+
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{}, 0)
+	f.Add([]byte{0}, 1)
+	f.Add([]byte("a queue that wraps around"), 5)
+	f.Fuzz(func(t *testing.T, data []byte, rotate int) {
+		values := codectest.Values(data)
+		capacity := len(values) + 2
+		q, d, c := NewQueue[int](), NewDeque[int](), NewCircularQueue[int](capacity, RejectWhenFull)
+		// Dequeue a few items first, so the encoded ring buffers do not start at index 0.
+		for range rotate % 8 {
+			q.Enqueue(0)
+			q.Dequeue()
+			d.PushBack(0)
+			d.PopFront()
+			c.Enqueue(0)
+			c.Dequeue()
+		}
+		for _, v := range values {
+			q.Enqueue(v)
+			d.PushBack(v)
+			c.Enqueue(v)
+		}
+
+		codectest.RoundTrip(t, "Queue", q, NewQueue[int], func(q *Queue[int]) []int { return slices.Collect(q.All()) }, values)
+		codectest.RoundTrip(t, "Deque", d, NewDeque[int], func(d *Deque[int]) []int { return slices.Collect(d.All()) }, values)
+		circular := func() *CircularQueue[int] { return NewCircularQueue[int](capacity, RejectWhenFull) }
+		codectest.RoundTrip(t, "CircularQueue", c, circular, (*CircularQueue[int]).Items, values)
+		zero := func() *CircularQueue[int] { return new(CircularQueue[int]) }
+		codectest.RoundTrip(t, "zero CircularQueue", c, zero, (*CircularQueue[int]).Items, values)
+
+		if len(values) < 2 {
+			return
+		}
+		for name, codec := range codectest.Codecs {
+			small := NewCircularQueue[int](len(values)-1, RejectWhenFull)
+			small.Enqueue(7)
+			if err := codec(c, small); !errors.Is(err, ErrFull) {
+				t.Fatalf("%s into a CircularQueue of capacity %d: %v, want ErrFull", name, len(values)-1, err)
+			}
+			if !slices.Equal(small.Items(), []int{7}) {
+				t.Fatalf("%s into a CircularQueue that is too small changed it to %v", name, small.Items())
+			}
+		}
+	})
+}
+
+func TestCircularDecodeKeepsPolicy(t *testing.T) {
+	c := NewCircularQueue[int](3, OverwriteOldest)
+	if err := c.UnmarshalJSON([]byte("[1,2]")); err != nil {
+		t.Fatal(err)
+	}
+	c.Enqueue(3)
+	c.Enqueue(4)
+	if got, want := c.Items(), []int{2, 3, 4}; !slices.Equal(got, want) || c.Cap() != 3 {
+		t.Fatalf("Items() = %v, Cap() = %d, want %v and 3", got, c.Cap(), want)
+	}
+}
@@ -0,0 +1,15 @@
+package queue
+
+import "fmt"
+
+// format implements fmt.Formatter for the queues of this package.
+// %v prints the items like a slice, %+v adds the size and capacity, %#v prints them as a Go slice literal
+// and any other verb is applied to each item.
+func format[T any](f fmt.State, verb rune, items []T, size, capacity int) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{size:%d cap:%d items:%+v}", size, capacity, items)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), items)
+	}
+}
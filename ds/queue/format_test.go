@@ -0,0 +1,68 @@
+package queue
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	q := NewQueue[int]()
+	for i := range 5 {
+		q.Enqueue(i)
+	}
+	q.Dequeue()
+	q.Dequeue()
+	d := NewDeque[int]()
+	d.PushBack(3)
+	d.PushBack(4)
+	d.PushFront(2)
+	c := NewCircularQueue[int](4, OverwriteOldest)
+	for i := range 5 {
+		c.Enqueue(i)
+	}
+	c.Dequeue()
+
+	// Every queue holds 2, 3, 4 with the front away from the start of its backing array.
+	queues := []struct {
+		name  string
+		queue fmt.Stringer
+		cap   int
+	}{
+		{"Queue", q, minCapacity},
+		{"Deque", d, minCapacity},
+		{"CircularQueue", c, 4},
+	}
+	for _, tt := range queues {
+		t.Run(tt.name, func(t *testing.T) {
+			tests := []struct {
+				format string
+				want   string
+			}{
+				{"%v", "[2 3 4]"},
+				{"%+v", fmt.Sprintf("{size:3 cap:%d items:[2 3 4]}", tt.cap)},
+				{"%#v", "[]int{2, 3, 4}"},
+				{"%02d", "[02 03 04]"},
+			}
+			if got := tt.queue.String(); got != "[2 3 4]" {
+				t.Errorf("String() = %q, want %q", got, "[2 3 4]")
+			}
+			for _, f := range tests {
+				if got := fmt.Sprintf(f.format, tt.queue); got != f.want {
+					t.Errorf("Sprintf(%q) = %q, want %q", f.format, got, f.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatEmpty(t *testing.T) {
+	for name, queue := range map[string]fmt.Stringer{
+		"Queue":         NewQueue[string](),
+		"Deque":         NewDeque[string](),
+		"CircularQueue": NewCircularQueue[string](1, RejectWhenFull),
+	} {
+		if got := fmt.Sprintf("%v %#v", queue, queue); got != "[] []string{}" {
+			t.Errorf("%s: Sprintf(%%v %%#v) = %q, want %q", name, got, "[] []string{}")
+		}
+	}
+}
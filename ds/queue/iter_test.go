@@ -0,0 +1,35 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+func TestIterators(t *testing.T) {
+	for _, n := range []int{0, 1, 6} {
+		q, d, c := NewQueue[int](), NewDeque[int](), NewCircularQueue[int](8, RejectWhenFull)
+		// Move the fronts away from index 0, so the iterators cross the wrap of each buffer.
+		for range 5 {
+			q.Enqueue(0)
+			q.Dequeue()
+			d.PushBack(0)
+			d.PopFront()
+			c.Enqueue(0)
+			c.Dequeue()
+		}
+		values, indexes, backward := make([]int, n), make([]int, n), make([]int, n)
+		for i := range n {
+			values[i], indexes[i], backward[n-1-i] = i, i, i
+			q.Enqueue(i)
+			d.PushBack(i)
+			c.Enqueue(i)
+		}
+		itertest.Seq(t, "Queue.All", q.All(), values)
+		itertest.Seq(t, "Queue.Backward", q.Backward(), backward)
+		itertest.Seq(t, "Deque.All", d.All(), values)
+		itertest.Seq2(t, "Deque.Enumerate", d.Enumerate(), indexes, values)
+		itertest.Seq(t, "Deque.Backward", d.Backward(), backward)
+		itertest.Seq(t, "CircularQueue.All", c.All(), values)
+	}
+}
@@ -1,6 +1,21 @@
 package queue
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var (
+	// ErrEmpty is returned when taking an item from an empty queue without waiting.
+	ErrEmpty = errors.New("queue: empty")
+	// ErrFull is returned when adding an item to a full queue without waiting.
+	ErrFull = errors.New("queue: full")
+	// ErrIndexOutOfRange is returned when an index does not point to an item of the queue.
+	ErrIndexOutOfRange = errors.New("queue: index out of range")
+	// ErrClosed is returned when adding to a closed queue, or taking from one that is closed and drained.
+	ErrClosed = errors.New("queue: closed")
+)
 
 // # Queue - Data Structure
 
@@ -66,50 +81,69 @@ import "fmt"
 // ## Steps for ENQUEUE
 
 // 1) Check the queue is full or not
-// 2) If full, print overflow and exit
+// 2) If full, report overflow and exit
 // 3) If queue is not full, increment tail and add the element
 
 // ## Steps for DEQUEUE
 
 // 1) Check queue is empty or not
-// 2) if empty, print underflow and exit
-// 3) if not empty, print element at the head and increment head
-type Queue[T comparable] struct {
+// 2) if empty, report underflow and exit
+// 3) if not empty, return element at the head and increment head
+
+// ## Queue below
+
+// Queue is an array implementation that increases front and rear in circular manner. The backing array doubles when it is full and halves when it is at most a quarter full, so Enqueue and Dequeue are amortized O(1) and a long running queue does not hold on to memory it no longer needs. Dequeued slots are zeroed so the items they held can be garbage collected.
+type Queue[T any] struct {
 	items []T
+	head  int
 	size  int
 }
 
+// minCapacity is the smallest backing array a non-empty queue uses.
+const minCapacity = 8
+
 // NewQueue returns a new Queue.
-func NewQueue[T comparable]() *Queue[T] {
+func NewQueue[T any]() *Queue[T] {
 	return &Queue[T]{}
 }
 
 // Enqueue - adds an item to the back of the queue.
 func (q *Queue[T]) Enqueue(item T) {
-	q.items = append(q.items, item)
+	if q.size == len(q.items) {
+		n := 2 * len(q.items)
+		if n < minCapacity {
+			n = minCapacity
+		}
+		q.resize(n)
+	}
+	q.items[(q.head+q.size)%len(q.items)] = item
 	q.size++
 }
 
-// Dequeue - removes and returns the item at the front of the queue.
-func (q *Queue[T]) Dequeue() T {
+// Dequeue - removes and returns the item at the front of the queue, false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var empty T
 	if q.IsEmpty() {
-		var empty T
-		return empty
+		return empty, false
 	}
 
-	item := q.items[0]
-	q.items = q.items[1:]
+	item := q.items[q.head]
+	q.items[q.head] = empty
+	q.head = (q.head + 1) % len(q.items)
 	q.size--
-	return item
+	if len(q.items) > minCapacity && q.size <= len(q.items)/4 {
+		q.resize(len(q.items) / 2)
+	}
+	return item, true
 }
 
-// Peek - returns the item at the front of the queue without removing it.
-func (q *Queue[T]) Peek() T {
+// Peek - returns the item at the front of the queue without removing it, false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
 	if q.IsEmpty() {
 		var empty T
-		return empty
+		return empty, false
 	}
-	return q.items[0]
+	return q.items[q.head], true
 }
 
 // IsEmpty - returns true if the queue is empty.
@@ -122,13 +156,86 @@ func (q *Queue[T]) Size() int {
 	return q.size
 }
 
+// Cap - returns the number of items the queue can hold before it has to grow.
+func (q *Queue[T]) Cap() int {
+	return len(q.items)
+}
+
 // Clear - removes all items from the queue.
 func (q *Queue[T]) Clear() {
 	q.items = nil
+	q.head = 0
 	q.size = 0
 }
 
-// String - returns a string representation of the queue.
-func (q *Queue[T]) String() {
-	fmt.Println(q.items)
+// String - returns a string representation of the queue, front first.
+func (q *Queue[T]) String() string {
+	return fmt.Sprint(q.values())
+}
+
+// Format - implements fmt.Formatter: %v prints the items front first, %+v adds the size and capacity
+// and %#v prints them as a Go slice literal.
+func (q *Queue[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, q.values(), q.size, len(q.items))
+}
+
+// All - returns an iterator over the items from front to back.
+// The queue must not be modified during the iteration.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.items[(q.head+i)%len(q.items)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns an iterator over the items from back to front.
+// The queue must not be modified during the iteration.
+func (q *Queue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := q.size - 1; i >= 0; i-- {
+			if !yield(q.items[(q.head+i)%len(q.items)]) {
+				return
+			}
+		}
+	}
+}
+
+// pushFront puts an item back at the front of the queue, ahead of every other item.
+func (q *Queue[T]) pushFront(item T) {
+	if q.size == len(q.items) {
+		q.resize(max(2*len(q.items), minCapacity))
+	}
+	q.head = (q.head - 1 + len(q.items)) % len(q.items)
+	q.items[q.head] = item
+	q.size++
+}
+
+// values returns the items of the queue from front to back.
+func (q *Queue[T]) values() []T {
+	return unwrap(q.items, q.head, q.size, q.size)
+}
+
+// resize moves the items into a new backing array of length n, starting at index 0.
+func (q *Queue[T]) resize(n int) {
+	q.items = unwrap(q.items, q.head, q.size, n)
+	q.head = 0
+}
+
+// unwrap copies the size items of the circular buffer buf starting at head into
+// the beginning of a new slice of length n.
+func unwrap[T any](buf []T, head, size, n int) []T {
+	out := make([]T, n)
+	if size == 0 {
+		return out
+	}
+	if head+size <= len(buf) {
+		copy(out, buf[head:head+size])
+		return out
+	}
+	k := copy(out, buf[head:])
+	copy(out[k:], buf[:size-k])
+	return out
 }
@@ -0,0 +1,202 @@
+package queue
+
+import (
+	"fmt"
+	"testing"
+)
+
+// sliceQueue is the Queue this package had before the ring buffer: Dequeue re-slices the items,
+// so the backing array keeps every dequeued item reachable until the next append reallocates it.
+type sliceQueue[T any] struct {
+	items []T
+}
+
+func (q *sliceQueue[T]) Enqueue(item T) {
+	q.items = append(q.items, item)
+}
+
+func (q *sliceQueue[T]) Dequeue() (T, bool) {
+	if len(q.items) == 0 {
+		var empty T
+		return empty, false
+	}
+	item := q.items[0]
+	q.items = q.items[1:]
+	return item, true
+}
+
+// fifo is what the benchmarks need from both implementations.
+type fifo interface {
+	Enqueue(int)
+	Dequeue() (int, bool)
+}
+
+var implementations = []struct {
+	name string
+	new  func() fifo
+}{
+	{"ring", func() fifo { return NewQueue[int]() }},
+	{"slice", func() fifo { return &sliceQueue[int]{} }},
+}
+
+func TestQueueOrder(t *testing.T) {
+	q := NewQueue[int]()
+	in, next := 0, 0
+	for round := range 50 {
+		for range round * 7 {
+			q.Enqueue(in)
+			in++
+		}
+		for range round * 5 {
+			v, ok := q.Dequeue()
+			if !ok || v != next {
+				t.Fatalf("Dequeue() = %d, %v, want %d, true", v, ok, next)
+			}
+			next++
+		}
+	}
+	for q.Size() > 0 {
+		v, _ := q.Dequeue()
+		if v != next {
+			t.Fatalf("Dequeue() = %d, want %d", v, next)
+		}
+		next++
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Fatal("Dequeue() on an empty queue returned true")
+	}
+}
+
+func TestQueueEmpty(t *testing.T) {
+	for name, q := range map[string]*Queue[int]{"new": NewQueue[int](), "zero": {}} {
+		if _, ok := q.Dequeue(); ok {
+			t.Errorf("%s: Dequeue() on an empty queue = true", name)
+		}
+		if _, ok := q.Peek(); ok {
+			t.Errorf("%s: Peek() on an empty queue = true", name)
+		}
+		q.Enqueue(1)
+		if v, ok := q.Peek(); !ok || v != 1 {
+			t.Errorf("%s: Peek() = %d, %v, want 1, true", name, v, ok)
+		}
+		q.Dequeue()
+		if _, ok := q.Peek(); ok || !q.IsEmpty() {
+			t.Errorf("%s: Peek() on a drained queue = true", name)
+		}
+	}
+}
+
+func TestQueueShrinksAndZeroes(t *testing.T) {
+	q := NewQueue[*int]()
+	for i := range 1024 {
+		q.Enqueue(&i)
+	}
+	for range 1020 {
+		q.Dequeue()
+	}
+	if q.Cap() >= 1024 {
+		t.Errorf("Cap() = %d after draining, want the buffer to shrink", q.Cap())
+	}
+	live := 0
+	for _, p := range q.items {
+		if p != nil {
+			live++
+		}
+	}
+	if live != q.Size() {
+		t.Errorf("%d slots hold items, want %d: vacated slots must be zeroed", live, q.Size())
+	}
+}
+
+// TestQueueZeroesWithoutResize checks the slot Dequeue vacates while the buffer keeps its size, so
+// the check cannot pass just because a resize copied the items to a fresh, zeroed array.
+func TestQueueZeroesWithoutResize(t *testing.T) {
+	q := NewQueue[*int]()
+	for i := range 16 {
+		q.Enqueue(&i)
+	}
+	items := q.items
+	// dequeue checks the vacated slot of each Dequeue, the buffer must neither grow nor shrink.
+	dequeue := func(n int) {
+		t.Helper()
+		for range n {
+			head := q.head
+			q.Dequeue()
+			if &q.items[0] != &items[0] {
+				t.Fatal("Dequeue() resized the buffer, the test needs a backlog that does not shrink it")
+			}
+			if items[head] != nil {
+				t.Fatalf("slot %d still holds an item after Dequeue", head)
+			}
+		}
+	}
+	dequeue(8)
+	// Refill the front of the buffer, so the next Dequeues go across the wrap.
+	for i := range 8 {
+		q.Enqueue(&i)
+	}
+	if q.Cap() != 16 || q.head != 8 {
+		t.Fatalf("Cap() = %d, head = %d, want a full buffer of 16 wrapping at 8", q.Cap(), q.head)
+	}
+	dequeue(11)
+}
+
+// BenchmarkEnqueueDequeue keeps a steady backlog and moves one item through it per iteration,
+// the producer/consumer pattern where the slice queue keeps reallocating.
+func BenchmarkEnqueueDequeue(b *testing.B) {
+	for _, backlog := range []int{16, 1024} {
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s/backlog=%d", impl.name, backlog), func(b *testing.B) {
+				q := impl.new()
+				for i := range backlog {
+					q.Enqueue(i)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := range b.N {
+					q.Enqueue(i)
+					q.Dequeue()
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkBurst enqueues a burst of items and then drains it.
+func BenchmarkBurst(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s/n=%d", impl.name, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for range b.N {
+					q := impl.new()
+					for i := range n {
+						q.Enqueue(i)
+					}
+					for range n {
+						q.Dequeue()
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkReuse refills the same queue after draining it: the ring buffer shrinks back when it is
+// drained, the slice queue never reuses the front of its array.
+func BenchmarkReuse(b *testing.B) {
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			q := impl.new()
+			b.ReportAllocs()
+			for range b.N {
+				for i := range 64 {
+					q.Enqueue(i)
+				}
+				for range 64 {
+					q.Dequeue()
+				}
+			}
+		})
+	}
+}
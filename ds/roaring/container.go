@@ -0,0 +1,415 @@
+package roaring
+
+import (
+	"math/bits"
+	"slices"
+	"sort"
+)
+
+// arrayMaxSize is the largest cardinality an array container holds; past it a bitmap is smaller.
+const arrayMaxSize = 4096
+
+// container holds the low 16 bits of the values that share the same high 16 bits.
+// Methods that may change the representation return the container to use from then on.
+type container interface {
+	add(x uint16) container
+	remove(x uint16) container
+	contains(x uint16) bool
+	cardinality() int
+	numRuns() int
+	each(yield func(uint16) bool) bool
+	clone() container
+}
+
+// arrayContainer is a sorted array of values, for sparse containers.
+type arrayContainer struct {
+	values []uint16
+}
+
+// bitmapContainer is one bit per possible value, for dense containers.
+type bitmapContainer struct {
+	words [1024]uint64
+	card  int
+}
+
+// runContainer is a sorted list of runs of consecutive values, for clustered containers.
+type runContainer struct {
+	runs []interval
+}
+
+// interval is a run of the values from start to last, both included.
+type interval struct {
+	start, last uint16
+}
+
+func (a *arrayContainer) add(x uint16) container {
+	i, found := slices.BinarySearch(a.values, x)
+	if found {
+		return a
+	}
+	if len(a.values) == arrayMaxSize {
+		return toBitmap(a).add(x)
+	}
+	a.values = slices.Insert(a.values, i, x)
+	return a
+}
+
+func (a *arrayContainer) remove(x uint16) container {
+	if i, found := slices.BinarySearch(a.values, x); found {
+		a.values = slices.Delete(a.values, i, i+1)
+	}
+	return a
+}
+
+func (a *arrayContainer) contains(x uint16) bool {
+	_, found := slices.BinarySearch(a.values, x)
+	return found
+}
+
+func (a *arrayContainer) cardinality() int {
+	return len(a.values)
+}
+
+func (a *arrayContainer) numRuns() int {
+	runs := 0
+	for i, v := range a.values {
+		if i == 0 || a.values[i-1]+1 != v {
+			runs++
+		}
+	}
+	return runs
+}
+
+func (a *arrayContainer) each(yield func(uint16) bool) bool {
+	for _, v := range a.values {
+		if !yield(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *arrayContainer) clone() container {
+	return &arrayContainer{values: slices.Clone(a.values)}
+}
+
+func (b *bitmapContainer) add(x uint16) container {
+	mask := uint64(1) << (x % 64)
+	if b.words[x/64]&mask == 0 {
+		b.words[x/64] |= mask
+		b.card++
+	}
+	return b
+}
+
+func (b *bitmapContainer) remove(x uint16) container {
+	mask := uint64(1) << (x % 64)
+	if b.words[x/64]&mask == 0 {
+		return b
+	}
+	b.words[x/64] &^= mask
+	b.card--
+	if b.card <= arrayMaxSize {
+		return toArray(b)
+	}
+	return b
+}
+
+func (b *bitmapContainer) contains(x uint16) bool {
+	return b.words[x/64]&(1<<(x%64)) != 0
+}
+
+func (b *bitmapContainer) cardinality() int {
+	return b.card
+}
+
+func (b *bitmapContainer) numRuns() int {
+	runs := 0
+	for i, w := range b.words {
+		// A run starts at every set bit whose lower neighbour is not set.
+		prev := w << 1
+		if i > 0 {
+			prev |= b.words[i-1] >> 63
+		}
+		runs += bits.OnesCount64(w &^ prev)
+	}
+	return runs
+}
+
+func (b *bitmapContainer) each(yield func(uint16) bool) bool {
+	for i, w := range b.words {
+		for w != 0 {
+			if !yield(uint16(i*64 + bits.TrailingZeros64(w))) {
+				return false
+			}
+			w &= w - 1
+		}
+	}
+	return true
+}
+
+func (b *bitmapContainer) clone() container {
+	c := *b
+	return &c
+}
+
+// find returns the index of the run holding x, or -1, and the index of the first run starting after x.
+func (r *runContainer) find(x uint16) (int, int) {
+	next := sort.Search(len(r.runs), func(i int) bool { return r.runs[i].start > x })
+	if next > 0 && x <= r.runs[next-1].last {
+		return next - 1, next
+	}
+	return -1, next
+}
+
+func (r *runContainer) add(x uint16) container {
+	i, next := r.find(x)
+	if i >= 0 {
+		return r
+	}
+	joinsPrev := next > 0 && int(r.runs[next-1].last)+1 == int(x)
+	joinsNext := next < len(r.runs) && int(x)+1 == int(r.runs[next].start)
+	switch {
+	case joinsPrev && joinsNext:
+		r.runs[next-1].last = r.runs[next].last
+		r.runs = slices.Delete(r.runs, next, next+1)
+	case joinsPrev:
+		r.runs[next-1].last = x
+	case joinsNext:
+		r.runs[next].start = x
+	default:
+		r.runs = slices.Insert(r.runs, next, interval{start: x, last: x})
+	}
+	return r
+}
+
+func (r *runContainer) remove(x uint16) container {
+	i, _ := r.find(x)
+	if i < 0 {
+		return r
+	}
+	run := r.runs[i]
+	switch {
+	case run.start == run.last:
+		r.runs = slices.Delete(r.runs, i, i+1)
+	case x == run.start:
+		r.runs[i].start++
+	case x == run.last:
+		r.runs[i].last--
+	default:
+		r.runs[i].last = x - 1
+		r.runs = slices.Insert(r.runs, i+1, interval{start: x + 1, last: run.last})
+	}
+	return r
+}
+
+func (r *runContainer) contains(x uint16) bool {
+	i, _ := r.find(x)
+	return i >= 0
+}
+
+func (r *runContainer) cardinality() int {
+	card := 0
+	for _, run := range r.runs {
+		card += int(run.last-run.start) + 1
+	}
+	return card
+}
+
+func (r *runContainer) numRuns() int {
+	return len(r.runs)
+}
+
+func (r *runContainer) each(yield func(uint16) bool) bool {
+	for _, run := range r.runs {
+		for v := int(run.start); v <= int(run.last); v++ {
+			if !yield(uint16(v)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (r *runContainer) clone() container {
+	return &runContainer{runs: slices.Clone(r.runs)}
+}
+
+// toBitmap returns a new bitmap container with the values of c.
+func toBitmap(c container) *bitmapContainer {
+	b := &bitmapContainer{}
+	switch c := c.(type) {
+	case *bitmapContainer:
+		*b = *c
+	case *runContainer:
+		for _, run := range c.runs {
+			setRange(&b.words, int(run.start), int(run.last))
+		}
+		b.card = c.cardinality()
+	default:
+		c.each(func(x uint16) bool {
+			b.words[x/64] |= 1 << (x % 64)
+			return true
+		})
+		b.card = c.cardinality()
+	}
+	return b
+}
+
+// toArray returns a new array container with the values of c, which must have at most arrayMaxSize values.
+func toArray(c container) *arrayContainer {
+	a := &arrayContainer{values: make([]uint16, 0, c.cardinality())}
+	c.each(func(x uint16) bool {
+		a.values = append(a.values, x)
+		return true
+	})
+	return a
+}
+
+// toRuns returns a new run container with the values of c.
+func toRuns(c container) *runContainer {
+	r := &runContainer{runs: make([]interval, 0, c.numRuns())}
+	c.each(func(x uint16) bool {
+		if n := len(r.runs); n > 0 && int(r.runs[n-1].last)+1 == int(x) {
+			r.runs[n-1].last = x
+		} else {
+			r.runs = append(r.runs, interval{start: x, last: x})
+		}
+		return true
+	})
+	return r
+}
+
+// setRange sets the bits from start to last, both included.
+func setRange(words *[1024]uint64, start, last int) {
+	for i := start / 64; i <= last/64; i++ {
+		w := ^uint64(0)
+		if i == start/64 {
+			w &= ^uint64(0) << (start % 64)
+		}
+		if i == last/64 {
+			w &= ^uint64(0) >> (63 - last%64)
+		}
+		words[i] |= w
+	}
+}
+
+// normalize returns the cheapest of array and bitmap for the values of b, nil if b is empty.
+func normalize(b *bitmapContainer) container {
+	b.card = 0
+	for _, w := range b.words {
+		b.card += bits.OnesCount64(w)
+	}
+	switch {
+	case b.card == 0:
+		return nil
+	case b.card <= arrayMaxSize:
+		return toArray(b)
+	}
+	return b
+}
+
+// optimize returns the smallest representation of c, counting its serialized size.
+func optimize(c container) container {
+	card := c.cardinality()
+	runBytes := 2 + 4*c.numRuns()
+	arrayBytes := 2 * card
+	if card > arrayMaxSize {
+		arrayBytes = 8192
+	}
+	switch _, isRun := c.(*runContainer); {
+	case runBytes < arrayBytes:
+		if isRun {
+			return c
+		}
+		return toRuns(c)
+	case card <= arrayMaxSize:
+		return toArray(c)
+	}
+	return toBitmap(c)
+}
+
+// or returns a new container with the values of a or b.
+func or(a, b container) container {
+	if a, ok := a.(*arrayContainer); ok {
+		if b, ok := b.(*arrayContainer); ok && len(a.values)+len(b.values) <= arrayMaxSize {
+			return &arrayContainer{values: mergeSorted(a.values, b.values)}
+		}
+	}
+	x, y := toBitmap(a), bitmapOf(b)
+	for i := range x.words {
+		x.words[i] |= y.words[i]
+	}
+	return normalize(x)
+}
+
+// and returns a new container with the values of a and b, nil if there are none.
+func and(a, b container) container {
+	if _, ok := b.(*arrayContainer); ok {
+		a, b = b, a
+	}
+	if a, ok := a.(*arrayContainer); ok {
+		return filter(a, b.contains)
+	}
+	x, y := toBitmap(a), bitmapOf(b)
+	for i := range x.words {
+		x.words[i] &= y.words[i]
+	}
+	return normalize(x)
+}
+
+// andNot returns a new container with the values of a that are not in b, nil if there are none.
+func andNot(a, b container) container {
+	if a, ok := a.(*arrayContainer); ok {
+		return filter(a, func(x uint16) bool { return !b.contains(x) })
+	}
+	x, y := toBitmap(a), bitmapOf(b)
+	for i := range x.words {
+		x.words[i] &^= y.words[i]
+	}
+	return normalize(x)
+}
+
+// bitmapOf returns c as a bitmap, converting it only if needed. The result must not be modified.
+func bitmapOf(c container) *bitmapContainer {
+	if b, ok := c.(*bitmapContainer); ok {
+		return b
+	}
+	return toBitmap(c)
+}
+
+// filter returns a new array container with the values of a that keep returns true for, nil if there are none.
+func filter(a *arrayContainer, keep func(uint16) bool) container {
+	values := make([]uint16, 0, len(a.values))
+	for _, v := range a.values {
+		if keep(v) {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return &arrayContainer{values: values}
+}
+
+// mergeSorted returns the sorted union of two sorted slices.
+func mergeSorted(a, b []uint16) []uint16 {
+	merged := make([]uint16, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			merged = append(merged, a[i])
+			i++
+		case a[i] > b[j]:
+			merged = append(merged, b[j])
+			j++
+		default:
+			merged = append(merged, a[i])
+			i++
+			j++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
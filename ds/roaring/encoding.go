@@ -0,0 +1,236 @@
+package roaring
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// Bitmaps are serialized in the portable format of the Roaring format specification
+// (https://github.com/RoaringBitmap/RoaringFormatSpec), so they can be exchanged with the
+// Java, C and Go implementations. All integers are little endian.
+
+const (
+	serialCookieNoRuns = 12346
+	serialCookie       = 12347
+	// noOffsetThreshold is the number of containers below which a stream with runs has no offset header.
+	noOffsetThreshold = 4
+)
+
+// ErrInvalidFormat is returned by UnmarshalBinary for data that is not a serialized Bitmap.
+var ErrInvalidFormat = errors.New("roaring: invalid serialized bitmap")
+
+// MarshalBinary - Encodes the Bitmap in the portable Roaring format.
+func (b *Bitmap) MarshalBinary() ([]byte, error) {
+	n := len(b.containers)
+	hasRuns := false
+	for _, c := range b.containers {
+		if _, ok := c.(*runContainer); ok {
+			hasRuns = true
+			break
+		}
+	}
+
+	var data []byte
+	if hasRuns {
+		data = binary.LittleEndian.AppendUint32(data, serialCookie|uint32(n-1)<<16)
+		flags := make([]byte, (n+7)/8)
+		for i, c := range b.containers {
+			if _, ok := c.(*runContainer); ok {
+				flags[i/8] |= 1 << (i % 8)
+			}
+		}
+		data = append(data, flags...)
+	} else {
+		data = binary.LittleEndian.AppendUint32(data, serialCookieNoRuns)
+		data = binary.LittleEndian.AppendUint32(data, uint32(n))
+	}
+	for i, c := range b.containers {
+		data = binary.LittleEndian.AppendUint16(data, b.keys[i])
+		data = binary.LittleEndian.AppendUint16(data, uint16(c.cardinality()-1))
+	}
+	if !hasRuns || n >= noOffsetThreshold {
+		offset := len(data) + 4*n
+		for _, c := range b.containers {
+			data = binary.LittleEndian.AppendUint32(data, uint32(offset))
+			offset += serializedSize(c)
+		}
+	}
+	for _, c := range b.containers {
+		data = appendContainer(data, c)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replaces the values of the Bitmap with a Bitmap encoded in the portable Roaring format.
+func (b *Bitmap) UnmarshalBinary(data []byte) error {
+	r := reader{data: data}
+	cookie, ok := r.uint32()
+	if !ok {
+		return ErrInvalidFormat
+	}
+
+	var n int
+	var runFlags []byte
+	switch {
+	case cookie&0xFFFF == serialCookie:
+		n = int(cookie>>16) + 1
+		if runFlags, ok = r.bytes((n + 7) / 8); !ok {
+			return ErrInvalidFormat
+		}
+	case cookie == serialCookieNoRuns:
+		size, ok := r.uint32()
+		if !ok || size > 1<<16 {
+			return ErrInvalidFormat
+		}
+		n = int(size)
+	default:
+		return ErrInvalidFormat
+	}
+
+	keys := make([]uint16, n)
+	cards := make([]int, n)
+	for i := range n {
+		key, ok1 := r.uint16()
+		card, ok2 := r.uint16()
+		if !ok1 || !ok2 || (i > 0 && key <= keys[i-1]) {
+			return ErrInvalidFormat
+		}
+		keys[i], cards[i] = key, int(card)+1
+	}
+	if runFlags == nil || n >= noOffsetThreshold {
+		if _, ok := r.bytes(4 * n); !ok {
+			return ErrInvalidFormat
+		}
+	}
+
+	containers := make([]container, n)
+	for i := range n {
+		var c container
+		switch {
+		case runFlags != nil && runFlags[i/8]&(1<<(i%8)) != 0:
+			c, ok = r.runs()
+		case cards[i] > arrayMaxSize:
+			c, ok = r.bitmap()
+		default:
+			c, ok = r.array(cards[i])
+		}
+		if !ok || c.cardinality() != cards[i] {
+			return ErrInvalidFormat
+		}
+		containers[i] = c
+	}
+	b.keys, b.containers = keys, containers
+	return nil
+}
+
+// serializedSize returns the number of bytes appendContainer writes for c.
+func serializedSize(c container) int {
+	switch c := c.(type) {
+	case *runContainer:
+		return 2 + 4*len(c.runs)
+	case *bitmapContainer:
+		return 8 * len(c.words)
+	}
+	return 2 * c.cardinality()
+}
+
+// appendContainer appends the serialized values of c to data.
+func appendContainer(data []byte, c container) []byte {
+	switch c := c.(type) {
+	case *runContainer:
+		data = binary.LittleEndian.AppendUint16(data, uint16(len(c.runs)))
+		for _, run := range c.runs {
+			data = binary.LittleEndian.AppendUint16(data, run.start)
+			data = binary.LittleEndian.AppendUint16(data, run.last-run.start)
+		}
+	case *bitmapContainer:
+		for _, w := range c.words {
+			data = binary.LittleEndian.AppendUint64(data, w)
+		}
+	case *arrayContainer:
+		for _, v := range c.values {
+			data = binary.LittleEndian.AppendUint16(data, v)
+		}
+	}
+	return data
+}
+
+// reader reads little endian values from data, reporting false once it runs out of data.
+type reader struct {
+	data []byte
+}
+
+func (r *reader) bytes(n int) ([]byte, bool) {
+	if n > len(r.data) {
+		return nil, false
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b, true
+}
+
+func (r *reader) uint16() (uint16, bool) {
+	b, ok := r.bytes(2)
+	if !ok {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint16(b), true
+}
+
+func (r *reader) uint32() (uint32, bool) {
+	b, ok := r.bytes(4)
+	if !ok {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(b), true
+}
+
+func (r *reader) array(card int) (container, bool) {
+	b, ok := r.bytes(2 * card)
+	if !ok {
+		return nil, false
+	}
+	values := make([]uint16, card)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint16(b[2*i:])
+		if i > 0 && values[i] <= values[i-1] {
+			return nil, false
+		}
+	}
+	return &arrayContainer{values: values}, true
+}
+
+func (r *reader) bitmap() (container, bool) {
+	b, ok := r.bytes(8 * 1024)
+	if !ok {
+		return nil, false
+	}
+	c := &bitmapContainer{}
+	for i := range c.words {
+		c.words[i] = binary.LittleEndian.Uint64(b[8*i:])
+		c.card += bits.OnesCount64(c.words[i])
+	}
+	return c, true
+}
+
+func (r *reader) runs() (container, bool) {
+	n, ok := r.uint16()
+	if !ok {
+		return nil, false
+	}
+	b, ok := r.bytes(4 * int(n))
+	if !ok {
+		return nil, false
+	}
+	c := &runContainer{runs: make([]interval, n)}
+	for i := range c.runs {
+		start := binary.LittleEndian.Uint16(b[4*i:])
+		length := binary.LittleEndian.Uint16(b[4*i+2:])
+		if int(start)+int(length) > 0xFFFF || (i > 0 && start <= c.runs[i-1].last) {
+			return nil, false
+		}
+		c.runs[i] = interval{start: start, last: start + length}
+	}
+	return c, true
+}
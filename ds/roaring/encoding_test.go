@@ -0,0 +1,193 @@
+package roaring
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// references are Bitmaps serialized by hand following the Roaring format specification, one per layout:
+// no runs (with an offset header), runs in fewer than noOffsetThreshold containers (without one) and
+// runs in noOffsetThreshold containers (with one again).
+var references = []struct {
+	name     string
+	data     []byte
+	values   []uint32
+	optimize bool
+}{
+	{
+		name: "no runs",
+		data: []byte{
+			0x3A, 0x30, 0, 0, // cookie 12346
+			2, 0, 0, 0, // 2 containers
+			0, 0, 2, 0, // key 0, 3 values
+			1, 0, 0, 0, // key 1, 1 value
+			24, 0, 0, 0, // offset of the first container
+			30, 0, 0, 0, // offset of the second container
+			1, 0, 2, 0, 0xE8, 0x03, // 1, 2, 1000
+			5, 0, // 65536+5
+		},
+		values: []uint32{1, 2, 1000, 1<<16 | 5},
+	},
+	{
+		name: "one run container",
+		data: []byte{
+			0x3B, 0x30, 0, 0, // cookie 12347, 1 container
+			0x01,        // the container is a run container
+			0, 0, 99, 0, // key 0, 100 values
+			1, 0, // 1 run
+			10, 0, 99, 0, // from 10, 99 more values
+		},
+		values:   rangeOf(10, 110),
+		optimize: true,
+	},
+	{
+		name: "four containers with runs",
+		data: []byte{
+			0x3B, 0x30, 3, 0, // cookie 12347, 4 containers
+			0x08,       // the fourth container is a run container
+			0, 0, 0, 0, // key 0, 1 value
+			1, 0, 0, 0, // key 1, 1 value
+			2, 0, 0, 0, // key 2, 1 value
+			3, 0, 9, 0, // key 3, 10 values
+			37, 0, 0, 0, 39, 0, 0, 0, 41, 0, 0, 0, 43, 0, 0, 0, // offsets
+			0, 0, 0, 0, 0, 0, // 0, 1<<16, 2<<16
+			1, 0, 0, 0, 9, 0, // 1 run of 3<<16 and 9 more values
+		},
+		values:   append([]uint32{0, 1 << 16, 2 << 16}, rangeOf(3<<16, 3<<16+10)...),
+		optimize: true,
+	},
+}
+
+// rangeOf returns the values from lo up to hi, hi excluded.
+func rangeOf(lo, hi uint32) []uint32 {
+	values := make([]uint32, 0, hi-lo)
+	for v := lo; v < hi; v++ {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestReferenceEncoding(t *testing.T) {
+	for _, ref := range references {
+		t.Run(ref.name, func(t *testing.T) {
+			b := New()
+			if err := b.UnmarshalBinary(ref.data); err != nil {
+				t.Fatalf("UnmarshalBinary() = %v", err)
+			}
+			check(t, "decoded", b)
+			if got := b.Values(); !slices.Equal(got, ref.values) {
+				t.Fatalf("UnmarshalBinary() = %v, want %v", got, ref.values)
+			}
+
+			built := Of(ref.values...)
+			if ref.optimize {
+				built.RunOptimize()
+			}
+			if data, _ := built.MarshalBinary(); !slices.Equal(data, ref.data) {
+				t.Fatalf("MarshalBinary() = % x, want % x", data, ref.data)
+			}
+
+			// Every truncation of the data is rejected.
+			for n := range len(ref.data) {
+				if err := New().UnmarshalBinary(ref.data[:n]); !errors.Is(err, ErrInvalidFormat) {
+					t.Fatalf("UnmarshalBinary() of the first %d bytes = %v, want ErrInvalidFormat", n, err)
+				}
+			}
+		})
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	inputs := []struct {
+		name   string
+		values []uint32
+	}{
+		{"empty", nil},
+		{"max value", []uint32{0, 1<<32 - 1}},
+	}
+	for _, d := range datasets {
+		inputs = append(inputs, struct {
+			name   string
+			values []uint32
+		}{d.name, d.values})
+	}
+	for seed := range uint64(5) {
+		inputs = append(inputs, struct {
+			name   string
+			values []uint32
+		}{fmt.Sprintf("boundary seed=%d", seed), boundaryValues(rand.New(rand.NewPCG(seed, seed)))})
+	}
+
+	for _, in := range inputs {
+		t.Run(in.name, func(t *testing.T) {
+			b := Of(in.values...)
+			roundTrip(t, "before RunOptimize", b, serialCookieNoRuns)
+			b.RunOptimize()
+			cookie := serialCookieNoRuns
+			for _, c := range b.containers {
+				if _, ok := c.(*runContainer); ok {
+					cookie = serialCookie
+				}
+			}
+			roundTrip(t, "after RunOptimize", b, cookie)
+		})
+	}
+}
+
+// roundTrip checks that b encodes with the given cookie and decodes to a Bitmap with the same values and
+// containers, which encodes to the same bytes again.
+func roundTrip(t *testing.T, name string, b *Bitmap, cookie int) {
+	t.Helper()
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("%s: MarshalBinary() = %v", name, err)
+	}
+	if got := int(binary.LittleEndian.Uint16(data)); got != cookie {
+		t.Fatalf("%s: cookie %d, want %d", name, got, cookie)
+	}
+	decoded := Of(7, 1<<20)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("%s: UnmarshalBinary() = %v", name, err)
+	}
+	check(t, name, decoded)
+	if !decoded.Equal(b) || !slices.Equal(decoded.Values(), b.Values()) {
+		t.Fatalf("%s: decoded %d values, want %d", name, decoded.Len(), b.Len())
+	}
+	for i, c := range decoded.containers {
+		if got, want := fmt.Sprintf("%T", c), fmt.Sprintf("%T", b.containers[i]); got != want {
+			t.Fatalf("%s: container %d decoded as %s, want %s", name, i, got, want)
+		}
+	}
+	if again, _ := decoded.MarshalBinary(); !slices.Equal(again, data) {
+		t.Fatalf("%s: encoding the decoded Bitmap gives other bytes", name)
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unknown cookie", []byte{1, 2, 3, 4, 0, 0, 0, 0}},
+		{"too many containers", []byte{0x3A, 0x30, 0, 0, 1, 0, 1, 0}},
+		{"keys not ascending", []byte{0x3A, 0x30, 0, 0, 2, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 24, 0, 0, 0, 26, 0, 0, 0, 1, 0, 2, 0}},
+		{"array not sorted", []byte{0x3A, 0x30, 0, 0, 1, 0, 0, 0, 0, 0, 1, 0, 16, 0, 0, 0, 2, 0, 1, 0}},
+		{"run past 65535", []byte{0x3B, 0x30, 0, 0, 1, 0, 0, 1, 0, 1, 0, 0xFF, 0xFF, 1, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Of(1, 2, 3)
+			if err := b.UnmarshalBinary(tt.data); !errors.Is(err, ErrInvalidFormat) {
+				t.Fatalf("UnmarshalBinary() = %v, want ErrInvalidFormat", err)
+			}
+			if !slices.Equal(b.Values(), []uint32{1, 2, 3}) {
+				t.Fatalf("a failed UnmarshalBinary() changed the Bitmap to %v", b)
+			}
+		})
+	}
+}
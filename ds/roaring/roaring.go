@@ -0,0 +1,275 @@
+package roaring
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// # Roaring Bitmap - Data Structure
+
+// A Roaring Bitmap is a compressed set of 32-bit unsigned integers. It splits every value into its high and low 16 bits: the high bits pick a container and the container stores the low bits. Each container uses whichever of three representations is the smallest for the values it holds:
+
+// - Array container: a sorted array of up to 4096 values, 2 bytes per value. Good for sparse data.
+// - Bitmap container: 65536 bits, always 8 KiB. Good for dense data, where it beats the array past 4096 values.
+// - Run container: a sorted list of runs of consecutive values, 4 bytes per run. Good for clustered data such as ranges of IDs.
+
+// So a set stays compact whether it is sparse, dense or a mix of both, unlike a map (tens of bytes per value) or a plain bitset (memory grows with the largest value).
+
+// ## Operations:
+// - Add/Remove/Contains: pick the container with a binary search over the high bits, then work inside it. Array containers turn into bitmaps when they grow past 4096 values and bitmaps turn back into arrays when they shrink.
+// - Union/Intersection/Difference: walk the sorted containers of both sets side by side and combine matching containers. Two bitmaps are combined 64 values at a time, an array is combined with anything by probing the other container.
+// - RunOptimize: converts every container to its smallest representation, including runs. Operations that create new containers only produce arrays and bitmaps, so call RunOptimize again after building a set that has long runs.
+
+// ## Usages:
+// - Inverted indexes in search engines and databases (Lucene, Druid, Pilosa, ClickHouse).
+// - ID membership and feature flag sets with millions of members.
+// - Fast set algebra over large sets of integers.
+
+// Bitmap is a Roaring Bitmap, a compressed set of uint32 values.
+type Bitmap struct {
+	// keys holds the high 16 bits shared by the values of each container, in ascending order.
+	keys       []uint16
+	containers []container
+}
+
+// New returns a new empty Bitmap.
+func New() *Bitmap {
+	return &Bitmap{}
+}
+
+// Of returns a new Bitmap holding the given values.
+func Of(values ...uint32) *Bitmap {
+	b := New()
+	for _, v := range values {
+		b.Add(v)
+	}
+	return b
+}
+
+// Add - Adds a value to the Bitmap.
+func (b *Bitmap) Add(value uint32) {
+	hi, lo := split(value)
+	i, found := slices.BinarySearch(b.keys, hi)
+	if !found {
+		b.keys = slices.Insert(b.keys, i, hi)
+		b.containers = slices.Insert(b.containers, i, container(&arrayContainer{}))
+	}
+	b.containers[i] = b.containers[i].add(lo)
+}
+
+// Remove - Removes a value from the Bitmap.
+func (b *Bitmap) Remove(value uint32) {
+	hi, lo := split(value)
+	i, found := slices.BinarySearch(b.keys, hi)
+	if !found {
+		return
+	}
+	b.containers[i] = b.containers[i].remove(lo)
+	if b.containers[i].cardinality() == 0 {
+		b.keys = slices.Delete(b.keys, i, i+1)
+		b.containers = slices.Delete(b.containers, i, i+1)
+	}
+}
+
+// Contains - Checks if a value is in the Bitmap.
+func (b *Bitmap) Contains(value uint32) bool {
+	hi, lo := split(value)
+	i, found := slices.BinarySearch(b.keys, hi)
+	return found && b.containers[i].contains(lo)
+}
+
+// Union - Returns a new Bitmap that is the union of two Bitmaps.
+func (b *Bitmap) Union(other *Bitmap) *Bitmap {
+	union := &Bitmap{}
+	i, j := 0, 0
+	for i < len(b.keys) && j < len(other.keys) {
+		switch {
+		case b.keys[i] < other.keys[j]:
+			union.append(b.keys[i], b.containers[i].clone())
+			i++
+		case b.keys[i] > other.keys[j]:
+			union.append(other.keys[j], other.containers[j].clone())
+			j++
+		default:
+			union.append(b.keys[i], or(b.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	for ; i < len(b.keys); i++ {
+		union.append(b.keys[i], b.containers[i].clone())
+	}
+	for ; j < len(other.keys); j++ {
+		union.append(other.keys[j], other.containers[j].clone())
+	}
+	return union
+}
+
+// Intersection - Returns a new Bitmap that is the intersection of two Bitmaps.
+func (b *Bitmap) Intersection(other *Bitmap) *Bitmap {
+	intersection := &Bitmap{}
+	i, j := 0, 0
+	for i < len(b.keys) && j < len(other.keys) {
+		switch {
+		case b.keys[i] < other.keys[j]:
+			i++
+		case b.keys[i] > other.keys[j]:
+			j++
+		default:
+			intersection.append(b.keys[i], and(b.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	return intersection
+}
+
+// Difference - Returns a new Bitmap with the values of b that are not in other.
+func (b *Bitmap) Difference(other *Bitmap) *Bitmap {
+	difference := &Bitmap{}
+	j := 0
+	for i, key := range b.keys {
+		for j < len(other.keys) && other.keys[j] < key {
+			j++
+		}
+		if j < len(other.keys) && other.keys[j] == key {
+			difference.append(key, andNot(b.containers[i], other.containers[j]))
+		} else {
+			difference.append(key, b.containers[i].clone())
+		}
+	}
+	return difference
+}
+
+// Subset - Checks if b is a subset of other.
+func (b *Bitmap) Subset(other *Bitmap) bool {
+	j := 0
+	for i, key := range b.keys {
+		for j < len(other.keys) && other.keys[j] < key {
+			j++
+		}
+		if j == len(other.keys) || other.keys[j] != key {
+			return false
+		}
+		if b.containers[i].cardinality() > other.containers[j].cardinality() {
+			return false
+		}
+		if andNot(b.containers[i], other.containers[j]) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal - Checks if two Bitmaps hold the same values.
+func (b *Bitmap) Equal(other *Bitmap) bool {
+	if !slices.Equal(b.keys, other.keys) {
+		return false
+	}
+	for i, c := range b.containers {
+		if c.cardinality() != other.containers[i].cardinality() || andNot(c, other.containers[i]) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty - Checks if the Bitmap is empty.
+func (b *Bitmap) Empty() bool {
+	return len(b.keys) == 0
+}
+
+// Len - Returns the number of values in the Bitmap.
+func (b *Bitmap) Len() int {
+	n := 0
+	for _, c := range b.containers {
+		n += c.cardinality()
+	}
+	return n
+}
+
+// Clear - Removes all values from the Bitmap.
+func (b *Bitmap) Clear() {
+	b.keys = nil
+	b.containers = nil
+}
+
+// Values - Returns a slice of all values in ascending order.
+func (b *Bitmap) Values() []uint32 {
+	values := make([]uint32, 0, b.Len())
+	for value := range b.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// All - Returns an iterator over the values in ascending order.
+func (b *Bitmap) All() iter.Seq[uint32] {
+	return func(yield func(uint32) bool) {
+		for i, c := range b.containers {
+			hi := uint32(b.keys[i]) << 16
+			if !c.each(func(lo uint16) bool { return yield(hi | uint32(lo)) }) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach - Calls a function for each value in ascending order.
+func (b *Bitmap) ForEach(f func(uint32)) {
+	for value := range b.All() {
+		f(value)
+	}
+}
+
+// Map - Returns a new Bitmap with the result of calling f on each value.
+func (b *Bitmap) Map(f func(uint32) uint32) *Bitmap {
+	mapped := New()
+	for value := range b.All() {
+		mapped.Add(f(value))
+	}
+	return mapped
+}
+
+// Filter - Returns a new Bitmap with the values for which f returns true.
+func (b *Bitmap) Filter(f func(uint32) bool) *Bitmap {
+	filtered := New()
+	for value := range b.All() {
+		if f(value) {
+			filtered.Add(value)
+		}
+	}
+	return filtered
+}
+
+// RunOptimize - Converts every container to its smallest representation, using run containers where they pay off.
+func (b *Bitmap) RunOptimize() {
+	for i, c := range b.containers {
+		b.containers[i] = optimize(c)
+	}
+}
+
+// String - Returns a string representation of the Bitmap, in ascending order.
+func (b *Bitmap) String() string {
+	values := make([]string, 0, b.Len())
+	for value := range b.All() {
+		values = append(values, fmt.Sprint(value))
+	}
+	return "{" + strings.Join(values, ", ") + "}"
+}
+
+// append adds a container after the last one, skipping nil (empty) containers.
+func (b *Bitmap) append(key uint16, c container) {
+	if c == nil {
+		return
+	}
+	b.keys = append(b.keys, key)
+	b.containers = append(b.containers, c)
+}
+
+// split returns the high and low 16 bits of value.
+func split(value uint32) (uint16, uint16) {
+	return uint16(value >> 16), uint16(value)
+}
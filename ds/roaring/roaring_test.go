@@ -0,0 +1,365 @@
+package roaring
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+	"github.com/rama-kairi/ds-algo/ds/set"
+)
+
+const benchLen = 100000
+
+// datasets are the value shapes Roaring Bitmaps are tuned for: sparse values spread over the whole
+// uint32 range, dense consecutive values and clustered runs of values.
+var datasets = []struct {
+	name   string
+	values []uint32
+}{
+	{"sparse", sparseValues(benchLen)},
+	{"dense", denseValues(benchLen)},
+	{"runs", runValues(benchLen)},
+}
+
+func sparseValues(n int) []uint32 {
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = r.Uint32()
+	}
+	return values
+}
+
+func denseValues(n int) []uint32 {
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = uint32(i)
+	}
+	return values
+}
+
+func runValues(n int) []uint32 {
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = uint32(i/1000*100000 + i%1000)
+	}
+	return values
+}
+
+func TestMap(t *testing.T) {
+	b := Of(1, 2, 3, 70000)
+	got := b.Map(func(v uint32) uint32 { return v / 2 }).Values()
+	if want := []uint32{0, 1, 35000}; !slices.Equal(got, want) {
+		t.Fatalf("Map() = %v, want %v", got, want)
+	}
+	if b.Len() != 4 {
+		t.Fatalf("Map() changed the receiver, Len() = %d", b.Len())
+	}
+}
+
+func TestAgainstSet(t *testing.T) {
+	for _, d := range datasets {
+		b, s := Of(d.values...), set.New[uint32]()
+		for _, v := range d.values {
+			s.Add(v)
+		}
+		if b.Len() != s.Len() {
+			t.Fatalf("%s: Len() = %d, want %d", d.name, b.Len(), s.Len())
+		}
+		b.RunOptimize()
+		if got, want := b.Values(), set.SortedOrdered(s); !slices.Equal(got, want) {
+			t.Fatalf("%s: Values() differ from the set after RunOptimize", d.name)
+		}
+	}
+}
+
+// boundaryValues returns random values in four containers. The first three hold a number of values picked
+// around arrayMaxSize, drawn from the same range of 2*arrayMaxSize so that containers of two such sets
+// overlap and the set algebra converts between arrays and bitmaps. The last one holds a range of
+// consecutive values, which RunOptimize turns into a run container.
+func boundaryValues(r *rand.Rand) []uint32 {
+	sizes := []int{0, 1, 100, arrayMaxSize - 1, arrayMaxSize, arrayMaxSize + 1, 6000}
+	var values []uint32
+	for key := range uint32(3) {
+		for _, lo := range r.Perm(2 * arrayMaxSize)[:sizes[r.IntN(len(sizes))]] {
+			values = append(values, key<<16|uint32(lo))
+		}
+	}
+	start := uint32(r.IntN(1000))
+	for lo := range uint32(sizes[r.IntN(len(sizes))]) {
+		values = append(values, 3<<16|(start+lo))
+	}
+	return values
+}
+
+// fill adds values to s, so that tests can build the unexported map-backed set.
+func fill[S interface{ Add(uint32) }](s S, values []uint32) S {
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// check checks the invariants of b: ascending keys, no empty container, sorted arrays of at most
+// arrayMaxSize values, bitmaps of more and sorted runs that do not touch.
+func check(t *testing.T, name string, b *Bitmap) {
+	t.Helper()
+	for i, c := range b.containers {
+		if i > 0 && b.keys[i] <= b.keys[i-1] {
+			t.Fatalf("%s: keys %v are not ascending", name, b.keys)
+		}
+		switch c := c.(type) {
+		case *arrayContainer:
+			if len(c.values) == 0 || len(c.values) > arrayMaxSize || !slices.IsSorted(c.values) {
+				t.Fatalf("%s: array container %d has %d values, sorted: %v", name, i, len(c.values), slices.IsSorted(c.values))
+			}
+		case *bitmapContainer:
+			n := 0
+			for _, w := range c.words {
+				n += bits.OnesCount64(w)
+			}
+			if n != c.card || c.card <= arrayMaxSize {
+				t.Fatalf("%s: bitmap container %d has %d values, card %d", name, i, n, c.card)
+			}
+		case *runContainer:
+			for j, run := range c.runs {
+				if run.last < run.start || j > 0 && int(run.start) <= int(c.runs[j-1].last)+1 {
+					t.Fatalf("%s: run container %d has runs %v", name, i, c.runs)
+				}
+			}
+			if len(c.runs) == 0 {
+				t.Fatalf("%s: run container %d is empty", name, i)
+			}
+		}
+	}
+}
+
+func TestSetAlgebraAgainstSet(t *testing.T) {
+	for seed := range uint64(30) {
+		r := rand.New(rand.NewPCG(seed, seed))
+		x, y := boundaryValues(r), boundaryValues(r)
+		refX, refY := fill(set.New[uint32](), x), fill(set.New[uint32](), y)
+		for _, optimized := range []bool{false, true} {
+			t.Run(fmt.Sprintf("seed=%d/optimized=%v", seed, optimized), func(t *testing.T) {
+				a, b := Of(x...), Of(y...)
+				if optimized {
+					a.RunOptimize()
+					b.RunOptimize()
+				}
+				check(t, "a", a)
+				check(t, "b", b)
+				results := []struct {
+					op   string
+					got  *Bitmap
+					want []uint32
+				}{
+					{"Union", a.Union(b), set.SortedOrdered(refX.Union(refY))},
+					{"Intersection", a.Intersection(b), set.SortedOrdered(refX.Intersection(refY))},
+					{"Difference", a.Difference(b), set.SortedOrdered(refX.Difference(refY))},
+					{"Difference the other way", b.Difference(a), set.SortedOrdered(refY.Difference(refX))},
+				}
+				for _, res := range results {
+					check(t, res.op, res.got)
+					if got := res.got.Values(); !slices.Equal(got, res.want) || res.got.Len() != len(res.want) {
+						t.Fatalf("%s has %d values, want %d", res.op, res.got.Len(), len(res.want))
+					}
+				}
+				if got, want := a.Subset(b), refX.Subset(refY); got != want {
+					t.Fatalf("Subset() = %v, want %v", got, want)
+				}
+				if got, want := a.Equal(b), refX.Equal(refY); got != want {
+					t.Fatalf("Equal() = %v, want %v", got, want)
+				}
+				if !a.Intersection(b).Subset(a) || !a.Subset(a.Union(b)) || !a.Equal(Of(x...)) {
+					t.Fatal("a is not between its intersection and its union with b, or not Equal to itself")
+				}
+				// The receivers are left unchanged.
+				if !slices.Equal(a.Values(), set.SortedOrdered(refX)) || !slices.Equal(b.Values(), set.SortedOrdered(refY)) {
+					t.Fatal("the set algebra changed its operands")
+				}
+			})
+		}
+	}
+}
+
+// TestRemoveAgainstSet removes every value of containers around arrayMaxSize in random order, so bitmaps
+// turn back into arrays and empty containers are dropped.
+func TestRemoveAgainstSet(t *testing.T) {
+	for seed := range uint64(4) {
+		r := rand.New(rand.NewPCG(seed, seed))
+		values := boundaryValues(r)
+		for _, optimized := range []bool{false, true} {
+			b, ref := Of(values...), fill(set.New[uint32](), values)
+			if optimized {
+				b.RunOptimize()
+			}
+			removals := append(slices.Clone(values), 5<<16, 1<<16|3*arrayMaxSize)
+			r.Shuffle(len(removals), func(i, j int) { removals[i], removals[j] = removals[j], removals[i] })
+			for step, v := range removals {
+				b.Remove(v)
+				ref.Remove(v)
+				check(t, fmt.Sprintf("seed %d step %d", seed, step), b)
+				if b.Contains(v) || b.Len() != ref.Len() {
+					t.Fatalf("seed %d: after Remove(%d) Contains() = true or Len() = %d, want %d", seed, v, b.Len(), ref.Len())
+				}
+				if step%500 == 0 && !slices.Equal(b.Values(), set.SortedOrdered(ref)) {
+					t.Fatalf("seed %d step %d: Values() differ from the set", seed, step)
+				}
+			}
+			if !b.Empty() || len(b.keys) != 0 {
+				t.Fatalf("seed %d: %d containers left after removing every value", seed, len(b.keys))
+			}
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	// An array, a bitmap and, after RunOptimize, a run container.
+	want := []uint32{1, 5}
+	for lo := range uint32(arrayMaxSize + 1) {
+		want = append(want, 1<<16|2*lo)
+	}
+	for lo := range uint32(10) {
+		want = append(want, 2<<16|(lo+10))
+	}
+	b := Of(want...)
+	b.RunOptimize()
+	itertest.Seq(t, "All", b.All(), want)
+	itertest.Seq(t, "All of an empty Bitmap", New().All(), nil)
+}
+
+// BenchmarkAdd builds a set from scratch; B/op is the memory each representation needs.
+func BenchmarkAdd(b *testing.B) {
+	for _, d := range datasets {
+		b.Run(fmt.Sprintf("roaring/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				bm := New()
+				for _, v := range d.values {
+					bm.Add(v)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("set/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				s := set.New[uint32]()
+				for _, v := range d.values {
+					s.Add(v)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSize reports the serialized size of each dataset, after RunOptimize for the Bitmap.
+func BenchmarkSize(b *testing.B) {
+	for _, d := range datasets {
+		b.Run(fmt.Sprintf("roaring/%s", d.name), func(b *testing.B) {
+			bm := Of(d.values...)
+			bm.RunOptimize()
+			b.ReportAllocs()
+			var size int
+			for range b.N {
+				data, _ := bm.MarshalBinary()
+				size = len(data)
+			}
+			b.ReportMetric(float64(size)/float64(len(d.values)), "bytes/value")
+		})
+		b.Run(fmt.Sprintf("set/%s", d.name), func(b *testing.B) {
+			s := set.New[uint32]()
+			for _, v := range d.values {
+				s.Add(v)
+			}
+			b.ReportAllocs()
+			var size int
+			for range b.N {
+				data, _ := s.MarshalBinary()
+				size = len(data)
+			}
+			b.ReportMetric(float64(size)/float64(len(d.values)), "bytes/value")
+		})
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	for _, d := range datasets {
+		bm, s := Of(d.values...), set.New[uint32]()
+		for _, v := range d.values {
+			s.Add(v)
+		}
+		b.Run(fmt.Sprintf("roaring/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := range b.N {
+				bm.Contains(d.values[i%len(d.values)])
+			}
+		})
+		b.Run(fmt.Sprintf("set/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := range b.N {
+				s.Contains(d.values[i%len(d.values)])
+			}
+		})
+	}
+}
+
+// BenchmarkUnion and BenchmarkIntersection combine each dataset with a copy of itself shifted by
+// half its length, so half of the values overlap.
+func BenchmarkUnion(b *testing.B) {
+	for _, d := range datasets {
+		first, second := halves(d.values)
+		bm1, bm2, s1, s2 := Of(first...), Of(second...), set.New[uint32](), set.New[uint32]()
+		for _, v := range first {
+			s1.Add(v)
+		}
+		for _, v := range second {
+			s2.Add(v)
+		}
+		b.Run(fmt.Sprintf("roaring/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				bm1.Union(bm2)
+			}
+		})
+		b.Run(fmt.Sprintf("set/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				s1.Union(s2)
+			}
+		})
+	}
+}
+
+func BenchmarkIntersection(b *testing.B) {
+	for _, d := range datasets {
+		first, second := halves(d.values)
+		bm1, bm2, s1, s2 := Of(first...), Of(second...), set.New[uint32](), set.New[uint32]()
+		for _, v := range first {
+			s1.Add(v)
+		}
+		for _, v := range second {
+			s2.Add(v)
+		}
+		b.Run(fmt.Sprintf("roaring/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				bm1.Intersection(bm2)
+			}
+		})
+		b.Run(fmt.Sprintf("set/%s", d.name), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				s1.Intersection(s2)
+			}
+		})
+	}
+}
+
+// halves splits values into two windows of the same length that overlap by half.
+func halves(values []uint32) ([]uint32, []uint32) {
+	n := len(values) / 2
+	return values[:n+n/2], values[n/2:]
+}
@@ -0,0 +1,149 @@
+package set
+
+import "errors"
+
+// SymmetricDifference - Returns a new Set with the values that are in exactly one of two Sets.
+func (s set[T]) SymmetricDifference(other set[T]) set[T] {
+	difference := make(set[T], 0)
+	for value := range s {
+		if !other.Contains(value) {
+			difference.Add(value)
+		}
+	}
+	for value := range other {
+		if !s.Contains(value) {
+			difference.Add(value)
+		}
+	}
+	return difference
+}
+
+// IsDisjoint - Checks if two Sets have no value in common.
+func (s set[T]) IsDisjoint(other set[T]) bool {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for value := range small {
+		if large.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubset - Checks if s is a subset of other and other has at least one more value.
+func (s set[T]) IsProperSubset(other set[T]) bool {
+	return len(s) < len(other) && s.Subset(other)
+}
+
+// IsSuperset - Checks if s holds every value of other.
+func (s set[T]) IsSuperset(other set[T]) bool {
+	return other.Subset(s)
+}
+
+// UnionWith - Adds every value of other to s, in place.
+func (s set[T]) UnionWith(other set[T]) {
+	for value := range other {
+		s.Add(value)
+	}
+}
+
+// IntersectWith - Removes from s every value that is not in other, in place.
+func (s set[T]) IntersectWith(other set[T]) {
+	for value := range s {
+		if !other.Contains(value) {
+			s.Remove(value)
+		}
+	}
+}
+
+// DifferenceWith - Removes from s every value that is in other, in place.
+func (s set[T]) DifferenceWith(other set[T]) {
+	for value := range other {
+		s.Remove(value)
+	}
+}
+
+// UnionAll - Returns a new Set that is the union of all the given Sets, empty if there are none.
+func UnionAll[T comparable](sets ...set[T]) set[T] {
+	largest := 0
+	for _, s := range sets {
+		largest = max(largest, len(s))
+	}
+	union := make(set[T], largest)
+	for _, s := range sets {
+		union.UnionWith(s)
+	}
+	return union
+}
+
+// IntersectAll - Returns a new Set that is the intersection of all the given Sets, empty if there are none.
+// Only the values of the smallest Set are checked against the others.
+func IntersectAll[T comparable](sets ...set[T]) set[T] {
+	intersection := make(set[T], 0)
+	if len(sets) == 0 {
+		return intersection
+	}
+	smallest := sets[0]
+	for _, s := range sets[1:] {
+		if len(s) < len(smallest) {
+			smallest = s
+		}
+	}
+next:
+	for value := range smallest {
+		for _, s := range sets {
+			if !s.Contains(value) {
+				continue next
+			}
+		}
+		intersection.Add(value)
+	}
+	return intersection
+}
+
+// MaxPowerSetLen - The largest Set PowerSet accepts. Its power set has over a million subsets, which
+// already take hundreds of megabytes.
+const MaxPowerSetLen = 20
+
+// ErrPowerSetTooLarge - Returned by PowerSet for Sets of more than MaxPowerSetLen values.
+var ErrPowerSetTooLarge = errors.New("set: too many values for a power set")
+
+// PowerSet - Returns every subset of a Set, from the empty Set to a copy of the Set itself.
+// There are 2^n subsets, so it is only practical for small Sets; it returns ErrPowerSetTooLarge when
+// n > MaxPowerSetLen.
+func (s set[T]) PowerSet() ([]set[T], error) {
+	if len(s) > MaxPowerSetLen {
+		return nil, ErrPowerSetTooLarge
+	}
+	values := s.Values()
+	subsets := make([]set[T], 0, 1<<len(values))
+	for mask := 0; mask < 1<<len(values); mask++ {
+		subset := make(set[T], 0)
+		for i, value := range values {
+			if mask&(1<<i) != 0 {
+				subset.Add(value)
+			}
+		}
+		subsets = append(subsets, subset)
+	}
+	return subsets, nil
+}
+
+// Pair - Pair is an ordered pair of values, the elements of a CartesianProduct.
+type Pair[T, U comparable] struct {
+	First  T
+	Second U
+}
+
+// CartesianProduct - Returns a new Set with every Pair made of a value of a and a value of b.
+func CartesianProduct[T, U comparable](a set[T], b set[U]) set[Pair[T, U]] {
+	product := make(set[Pair[T, U]], len(a)*len(b))
+	for first := range a {
+		for second := range b {
+			product.Add(Pair[T, U]{First: first, Second: second})
+		}
+	}
+	return product
+}
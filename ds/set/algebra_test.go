@@ -0,0 +1,210 @@
+package set
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// of returns a Set holding values.
+func of(values ...int) set[int] {
+	s := New[int]()
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+var pairs = []struct {
+	name string
+	a, b set[int]
+}{
+	{"both empty", of(), of()},
+	{"empty a", of(), of(1, 2)},
+	{"empty b", of(1, 2), of()},
+	{"disjoint", of(1, 3), of(2, 4)},
+	{"overlapping", of(1, 2, 3), of(2, 3, 4)},
+	{"proper subset", of(2), of(1, 2, 3)},
+	{"proper superset", of(1, 2, 3), of(3)},
+	{"equal", of(1, 2), of(2, 1)},
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	want := map[string][]int{
+		"both empty":      {},
+		"empty a":         {1, 2},
+		"empty b":         {1, 2},
+		"disjoint":        {1, 2, 3, 4},
+		"overlapping":     {1, 4},
+		"proper subset":   {1, 3},
+		"proper superset": {1, 2},
+		"equal":           {},
+	}
+	for _, tt := range pairs {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SortedOrdered(tt.a.SymmetricDifference(tt.b)); !slices.Equal(got, want[tt.name]) {
+				t.Fatalf("SymmetricDifference() = %v, want %v", got, want[tt.name])
+			}
+			if got := SortedOrdered(tt.b.SymmetricDifference(tt.a)); !slices.Equal(got, want[tt.name]) {
+				t.Fatalf("SymmetricDifference() the other way = %v, want %v", got, want[tt.name])
+			}
+		})
+	}
+}
+
+func TestRelations(t *testing.T) {
+	tests := map[string]struct {
+		disjoint, properSubset, superset bool
+	}{
+		"both empty":      {true, false, true},
+		"empty a":         {true, true, false},
+		"empty b":         {true, false, true},
+		"disjoint":        {true, false, false},
+		"overlapping":     {false, false, false},
+		"proper subset":   {false, true, false},
+		"proper superset": {false, false, true},
+		"equal":           {false, false, true},
+	}
+	for _, tt := range pairs {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tests[tt.name]
+			if got := tt.a.IsDisjoint(tt.b); got != want.disjoint {
+				t.Errorf("IsDisjoint() = %v, want %v", got, want.disjoint)
+			}
+			if got := tt.a.IsProperSubset(tt.b); got != want.properSubset {
+				t.Errorf("IsProperSubset() = %v, want %v", got, want.properSubset)
+			}
+			if got := tt.a.IsSuperset(tt.b); got != want.superset {
+				t.Errorf("IsSuperset() = %v, want %v", got, want.superset)
+			}
+		})
+	}
+}
+
+// TestInPlace checks that each *With method leaves s equal to the Set its counterpart returns, and other unchanged.
+func TestInPlace(t *testing.T) {
+	ops := []struct {
+		name    string
+		inPlace func(s, other set[int])
+		pure    func(s, other set[int]) set[int]
+	}{
+		{"UnionWith", set[int].UnionWith, set[int].Union},
+		{"IntersectWith", set[int].IntersectWith, set[int].Intersection},
+		{"DifferenceWith", set[int].DifferenceWith, set[int].Difference},
+	}
+	for _, tt := range pairs {
+		for _, op := range ops {
+			t.Run(tt.name+"/"+op.name, func(t *testing.T) {
+				s, other := tt.a.Union(of()), tt.b.Union(of())
+				want := op.pure(tt.a, tt.b)
+				op.inPlace(s, other)
+				if !s.Equal(want) {
+					t.Fatalf("%s() = %v, want %v", op.name, s, want)
+				}
+				if !other.Equal(tt.b) {
+					t.Fatalf("%s() changed other to %v, want %v", op.name, other, tt.b)
+				}
+			})
+		}
+	}
+}
+
+func TestUnionAllIntersectAll(t *testing.T) {
+	tests := []struct {
+		name                string
+		sets                []set[int]
+		union, intersection []int
+	}{
+		{"no sets", nil, []int{}, []int{}},
+		{"one set", []set[int]{of(1, 2)}, []int{1, 2}, []int{1, 2}},
+		{"one empty set", []set[int]{of(1, 2), of()}, []int{1, 2}, []int{}},
+		{"three sets", []set[int]{of(1, 2, 3, 4), of(2, 3, 5), of(0, 2, 3)}, []int{0, 1, 2, 3, 4, 5}, []int{2, 3}},
+		{"disjoint", []set[int]{of(1), of(2), of(3)}, []int{1, 2, 3}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SortedOrdered(UnionAll(tt.sets...)); !slices.Equal(got, tt.union) {
+				t.Errorf("UnionAll() = %v, want %v", got, tt.union)
+			}
+			if got := SortedOrdered(IntersectAll(tt.sets...)); !slices.Equal(got, tt.intersection) {
+				t.Errorf("IntersectAll() = %v, want %v", got, tt.intersection)
+			}
+		})
+	}
+	// The result is a new Set, even with a single argument.
+	s := of(1)
+	UnionAll(s).Add(2)
+	IntersectAll(s).Add(3)
+	if !s.Equal(of(1)) {
+		t.Fatalf("adding to the result of UnionAll or IntersectAll changed the argument to %v", s)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	for n := range 6 {
+		s := of()
+		for v := range n {
+			s.Add(v)
+		}
+		subsets, err := s.PowerSet()
+		if err != nil {
+			t.Fatalf("PowerSet() of %d values: %v", n, err)
+		}
+		if len(subsets) != 1<<n {
+			t.Fatalf("PowerSet() of %d values has %d subsets, want %d", n, len(subsets), 1<<n)
+		}
+		// Every subset is a distinct subset of s, so together they are all 2^n of them.
+		seen := map[int]bool{}
+		for _, subset := range subsets {
+			if !subset.Subset(s) {
+				t.Fatalf("PowerSet() of %v holds %v", s, subset)
+			}
+			mask := 0
+			for v := range subset {
+				mask |= 1 << v
+			}
+			if seen[mask] {
+				t.Fatalf("PowerSet() of %v holds %v twice", s, subset)
+			}
+			seen[mask] = true
+		}
+	}
+
+	large := of()
+	for v := range MaxPowerSetLen + 1 {
+		large.Add(v)
+	}
+	if _, err := large.PowerSet(); !errors.Is(err, ErrPowerSetTooLarge) {
+		t.Fatalf("PowerSet() of %d values = %v, want ErrPowerSetTooLarge", large.Len(), err)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	letters := New[string]()
+	letters.Add("a")
+	letters.Add("b")
+	tests := []struct {
+		name string
+		a    set[int]
+		want []Pair[int, string]
+	}{
+		{"empty", of(), []Pair[int, string]{}},
+		{"one value", of(1), []Pair[int, string]{{1, "a"}, {1, "b"}}},
+		{"two values", of(1, 2), []Pair[int, string]{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}},
+	}
+	byPair := func(x, y Pair[int, string]) int {
+		return cmp.Or(cmp.Compare(x.First, y.First), strings.Compare(x.Second, y.Second))
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CartesianProduct(tt.a, letters).SortedFunc(byPair); !slices.Equal(got, tt.want) {
+				t.Fatalf("CartesianProduct() = %v, want %v", got, tt.want)
+			}
+			if got := CartesianProduct(letters, New[int]()); got.Len() != 0 {
+				t.Fatalf("CartesianProduct() with an empty Set = %v, want empty", got)
+			}
+		})
+	}
+}
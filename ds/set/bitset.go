@@ -0,0 +1,314 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// BitSetOf - BitSetOf is a Set of unsigned integers stored as one bit per possible value, 64 values per word.
+// For dense domains of small integers it takes a bit per value instead of the tens of bytes a map entry costs,
+// and Union, Intersection and Difference work on 64 values at a time. Memory grows with the largest value,
+// not with the number of values, so it is a poor fit for a few huge values.
+type BitSetOf[T Unsigned] struct {
+	words []uint64
+}
+
+// BitSet - BitSet is a BitSetOf uint.
+type BitSet = BitSetOf[uint]
+
+// Unsigned - Unsigned is the set of unsigned integer types a BitSetOf can hold.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// ErrInvalidBitSet - Returned by UnmarshalBinary for data that is not a whole number of words.
+var ErrInvalidBitSet = errors.New("set: invalid bitset encoding")
+
+// ErrValueTooLarge - Returned by Add for values larger than MaxBitSetValue.
+var ErrValueTooLarge = errors.New("set: value larger than MaxBitSetValue")
+
+// NewBitSet - Creates a new BitSet.
+func NewBitSet() *BitSet {
+	return &BitSet{}
+}
+
+// NewBitSetOf - Creates a new BitSetOf T.
+func NewBitSetOf[T Unsigned]() *BitSetOf[T] {
+	return &BitSetOf[T]{}
+}
+
+// MaxBitSetValue - The largest value a BitSet can hold. Memory grows with the largest value, and a BitSet
+// holding MaxBitSetValue already takes 512 MiB.
+const MaxBitSetValue = 1<<32 - 1
+
+// Add - Adds a value to the BitSet. It returns ErrValueTooLarge, leaving the BitSet unchanged, if value is
+// larger than MaxBitSetValue.
+func (b *BitSetOf[T]) Add(value T) error {
+	if uint64(value) > MaxBitSetValue {
+		return ErrValueTooLarge
+	}
+	w := int(uint64(value) / 64)
+	if w >= len(b.words) {
+		words := make([]uint64, w+1, max(w+1, 2*len(b.words)))
+		copy(words, b.words)
+		b.words = words
+	}
+	b.words[w] |= 1 << (uint64(value) % 64)
+	return nil
+}
+
+// Remove - Removes a value from the BitSet.
+func (b *BitSetOf[T]) Remove(value T) {
+	w := uint64(value) / 64
+	if w < uint64(len(b.words)) {
+		b.words[w] &^= 1 << (uint64(value) % 64)
+		b.trim()
+	}
+}
+
+// Contains - Checks if a value is in the BitSet.
+func (b *BitSetOf[T]) Contains(value T) bool {
+	w := uint64(value) / 64
+	return w < uint64(len(b.words)) && b.words[w]&(1<<(uint64(value)%64)) != 0
+}
+
+// Union - Returns a new BitSet that is the union of two BitSets.
+func (b *BitSetOf[T]) Union(other *BitSetOf[T]) *BitSetOf[T] {
+	union := b.clone()
+	union.UnionWith(other)
+	return union
+}
+
+// Intersection - Returns a new BitSet that is the intersection of two BitSets.
+func (b *BitSetOf[T]) Intersection(other *BitSetOf[T]) *BitSetOf[T] {
+	intersection := b.clone()
+	intersection.IntersectWith(other)
+	return intersection
+}
+
+// Difference - Returns a new BitSet with the values of b that are not in other.
+func (b *BitSetOf[T]) Difference(other *BitSetOf[T]) *BitSetOf[T] {
+	difference := b.clone()
+	difference.DifferenceWith(other)
+	return difference
+}
+
+// SymmetricDifference - Returns a new BitSet with the values that are in exactly one of two BitSets.
+func (b *BitSetOf[T]) SymmetricDifference(other *BitSetOf[T]) *BitSetOf[T] {
+	difference := b.clone()
+	if len(other.words) > len(difference.words) {
+		difference.words = append(difference.words, make([]uint64, len(other.words)-len(difference.words))...)
+	}
+	for i, w := range other.words {
+		difference.words[i] ^= w
+	}
+	difference.trim()
+	return difference
+}
+
+// UnionWith - Adds every value of other to b, in place.
+func (b *BitSetOf[T]) UnionWith(other *BitSetOf[T]) {
+	if len(other.words) > len(b.words) {
+		b.words = append(b.words, make([]uint64, len(other.words)-len(b.words))...)
+	}
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+}
+
+// IntersectWith - Removes from b every value that is not in other, in place.
+func (b *BitSetOf[T]) IntersectWith(other *BitSetOf[T]) {
+	if len(b.words) > len(other.words) {
+		clear(b.words[len(other.words):])
+		b.words = b.words[:len(other.words)]
+	}
+	for i := range b.words {
+		b.words[i] &= other.words[i]
+	}
+	b.trim()
+}
+
+// DifferenceWith - Removes from b every value that is in other, in place.
+func (b *BitSetOf[T]) DifferenceWith(other *BitSetOf[T]) {
+	for i := range min(len(b.words), len(other.words)) {
+		b.words[i] &^= other.words[i]
+	}
+	b.trim()
+}
+
+// Subset - Checks if b is a subset of other.
+func (b *BitSetOf[T]) Subset(other *BitSetOf[T]) bool {
+	if len(b.words) > len(other.words) {
+		return false
+	}
+	for i, w := range b.words {
+		if w&^other.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal - Checks if two BitSets hold the same values.
+func (b *BitSetOf[T]) Equal(other *BitSetOf[T]) bool {
+	if len(b.words) != len(other.words) {
+		return false
+	}
+	for i, w := range b.words {
+		if w != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty - Checks if the BitSet is empty.
+func (b *BitSetOf[T]) Empty() bool {
+	return len(b.words) == 0
+}
+
+// Len - Returns the size of the BitSet.
+func (b *BitSetOf[T]) Len() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Clear - Removes all values from the BitSet.
+func (b *BitSetOf[T]) Clear() {
+	b.words = nil
+}
+
+// Min - Returns the smallest value, false if the BitSet is empty.
+func (b *BitSetOf[T]) Min() (T, bool) {
+	for i, w := range b.words {
+		if w != 0 {
+			return T(uint64(i)*64 + uint64(bits.TrailingZeros64(w))), true
+		}
+	}
+	return 0, false
+}
+
+// Max - Returns the largest value, false if the BitSet is empty.
+func (b *BitSetOf[T]) Max() (T, bool) {
+	if len(b.words) == 0 {
+		return 0, false
+	}
+	i := len(b.words) - 1
+	return T(uint64(i)*64 + uint64(63-bits.LeadingZeros64(b.words[i]))), true
+}
+
+// Rank - Returns the number of values in the BitSet that are less than value.
+func (b *BitSetOf[T]) Rank(value T) int {
+	w := uint64(value) / 64
+	if w >= uint64(len(b.words)) {
+		return b.Len()
+	}
+	n := 0
+	for _, word := range b.words[:w] {
+		n += bits.OnesCount64(word)
+	}
+	mask := uint64(1)<<(uint64(value)%64) - 1
+	return n + bits.OnesCount64(b.words[w]&mask)
+}
+
+// Select - Returns the k-th smallest value counting from 0, false if k is not in [0, Len()).
+func (b *BitSetOf[T]) Select(k int) (T, bool) {
+	if k < 0 {
+		return 0, false
+	}
+	for i, w := range b.words {
+		n := bits.OnesCount64(w)
+		if k >= n {
+			k -= n
+			continue
+		}
+		for ; k > 0; k-- {
+			w &= w - 1
+		}
+		return T(uint64(i)*64 + uint64(bits.TrailingZeros64(w))), true
+	}
+	return 0, false
+}
+
+// All - Returns an iterator over the values in ascending order.
+func (b *BitSetOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i, w := range b.words {
+			for w != 0 {
+				if !yield(T(uint64(i)*64 + uint64(bits.TrailingZeros64(w)))) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// ForEach - Calls a function for each value in ascending order.
+func (b *BitSetOf[T]) ForEach(f func(T)) {
+	for value := range b.All() {
+		f(value)
+	}
+}
+
+// Values - Returns a slice of all values in ascending order.
+func (b *BitSetOf[T]) Values() []T {
+	values := make([]T, 0, b.Len())
+	for value := range b.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// String - Returns a string representation of the BitSet, in ascending order.
+func (b *BitSetOf[T]) String() string {
+	values := make([]string, 0, b.Len())
+	for value := range b.All() {
+		values = append(values, fmt.Sprint(value))
+	}
+	return "{" + strings.Join(values, ", ") + "}"
+}
+
+// MarshalBinary - Encodes the BitSet as its words in little endian order, 8 bytes per 64 values.
+func (b *BitSetOf[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 8*len(b.words))
+	for _, w := range b.words {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary - Replaces the values of the BitSet with values encoded by MarshalBinary.
+func (b *BitSetOf[T]) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return ErrInvalidBitSet
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	b.words = words
+	b.trim()
+	return nil
+}
+
+// clone - Returns a copy of the BitSet.
+func (b *BitSetOf[T]) clone() *BitSetOf[T] {
+	return &BitSetOf[T]{words: append([]uint64(nil), b.words...)}
+}
+
+// trim - Drops the trailing zero words, so that equal BitSets have the same words.
+func (b *BitSetOf[T]) trim() {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	b.words = b.words[:n]
+}
@@ -0,0 +1,180 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestBitSetAddTooLarge(t *testing.T) {
+	b := NewBitSetOf[uint64]()
+	for _, v := range []uint64{MaxBitSetValue + 1, math.MaxUint64} {
+		if err := b.Add(v); !errors.Is(err, ErrValueTooLarge) {
+			t.Fatalf("Add(%d) = %v, want ErrValueTooLarge", v, err)
+		}
+	}
+	if !b.Empty() || b.Contains(MaxBitSetValue+1) || len(b.words) != 0 {
+		t.Fatalf("rejected values changed the BitSet to %v", b)
+	}
+}
+
+// bitSetPair returns two random BitSets and the map-backed Sets holding the same values. Their values
+// span several words, and the second one often has fewer words than the first.
+func bitSetPair(r *rand.Rand) (a, b *BitSet, refA, refB set[uint]) {
+	a, b, refA, refB = NewBitSet(), NewBitSet(), New[uint](), New[uint]()
+	limitA, limitB := uint(r.IntN(300)+1), uint(r.IntN(300)+1)
+	for range r.IntN(40) {
+		v := uint(r.IntN(int(limitA)))
+		a.Add(v)
+		refA.Add(v)
+	}
+	for range r.IntN(40) {
+		v := uint(r.IntN(int(limitB)))
+		b.Add(v)
+		refB.Add(v)
+	}
+	return a, b, refA, refB
+}
+
+// checkBitSet checks b against ref, including that trailing zero words were trimmed.
+func checkBitSet(t *testing.T, name string, b *BitSet, ref set[uint]) {
+	t.Helper()
+	want := SortedOrdered(ref)
+	if got := b.Values(); !slices.Equal(got, want) || b.Len() != len(want) || b.Empty() != (len(want) == 0) {
+		t.Fatalf("%s = %v, Len() = %d, want %v", name, got, b.Len(), want)
+	}
+	if n := len(b.words); n > 0 && b.words[n-1] == 0 {
+		t.Fatalf("%s ends with a zero word", name)
+	}
+}
+
+func TestBitSetAgainstSet(t *testing.T) {
+	for seed := range uint64(200) {
+		r := rand.New(rand.NewPCG(seed, seed))
+		a, b, refA, refB := bitSetPair(r)
+		t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+			checkBitSet(t, "Union", a.Union(b), refA.Union(refB))
+			checkBitSet(t, "Intersection", a.Intersection(b), refA.Intersection(refB))
+			checkBitSet(t, "Difference", a.Difference(b), refA.Difference(refB))
+			checkBitSet(t, "SymmetricDifference", a.SymmetricDifference(b), refA.SymmetricDifference(refB))
+			checkBitSet(t, "a", a, refA)
+			checkBitSet(t, "b", b, refB)
+
+			inPlace := []struct {
+				name string
+				op   func(x, y *BitSet)
+				want set[uint]
+			}{
+				{"UnionWith", (*BitSet).UnionWith, refA.Union(refB)},
+				{"IntersectWith", (*BitSet).IntersectWith, refA.Intersection(refB)},
+				{"DifferenceWith", (*BitSet).DifferenceWith, refA.Difference(refB)},
+			}
+			for _, op := range inPlace {
+				x := a.clone()
+				op.op(x, b)
+				checkBitSet(t, op.name, x, op.want)
+				checkBitSet(t, op.name+" other", b, refB)
+			}
+
+			if got, want := a.Subset(b), refA.Subset(refB); got != want {
+				t.Fatalf("Subset() = %v, want %v", got, want)
+			}
+			if got, want := a.Equal(b), refA.Equal(refB); got != want {
+				t.Fatalf("Equal() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBitSetRemoveTrims(t *testing.T) {
+	a, b := NewBitSet(), NewBitSet()
+	a.Add(1)
+	a.Add(200)
+	a.Remove(200)
+	b.Add(1)
+	if !a.Equal(b) || len(a.words) != 1 {
+		t.Fatalf("after Remove(200) %v has %d words, want it Equal to %v", a, len(a.words), b)
+	}
+	a.Remove(1)
+	a.Remove(5000)
+	if !a.Empty() || !a.Equal(NewBitSet()) {
+		t.Fatalf("after removing every value %v is not empty", a)
+	}
+}
+
+func TestBitSetOrderStatistics(t *testing.T) {
+	for seed := range uint64(50) {
+		r := rand.New(rand.NewPCG(seed, seed))
+		b, _, ref, _ := bitSetPair(r)
+		values := SortedOrdered(ref)
+
+		minimum, ok := b.Min()
+		if ok != (len(values) > 0) || ok && minimum != values[0] {
+			t.Fatalf("seed %d: Min() = %d, %v, want the first of %v", seed, minimum, ok, values)
+		}
+		maximum, ok := b.Max()
+		if ok != (len(values) > 0) || ok && maximum != values[len(values)-1] {
+			t.Fatalf("seed %d: Max() = %d, %v, want the last of %v", seed, maximum, ok, values)
+		}
+		for v := range uint(320) {
+			want, _ := slices.BinarySearch(values, v)
+			if got := b.Rank(v); got != want {
+				t.Fatalf("seed %d: Rank(%d) = %d, want %d", seed, v, got, want)
+			}
+		}
+		for k := -1; k <= len(values); k++ {
+			got, ok := b.Select(k)
+			if ok != (k >= 0 && k < len(values)) || ok && got != values[k] {
+				t.Fatalf("seed %d: Select(%d) = %d, %v in %v", seed, k, got, ok, values)
+			}
+		}
+	}
+}
+
+func TestBitSetMarshalBinary(t *testing.T) {
+	b := NewBitSetOf[uint16]()
+	for _, v := range []uint16{0, 9, 64, 191} {
+		b.Add(v)
+	}
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Three little endian words: bits 0 and 9, bit 0, bit 63.
+	want := []byte{
+		0x01, 0x02, 0, 0, 0, 0, 0, 0,
+		0x01, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x80,
+	}
+	if !slices.Equal(data, want) {
+		t.Fatalf("MarshalBinary() = %x, want %x", data, want)
+	}
+	got := NewBitSetOf[uint16]()
+	if err := got.UnmarshalBinary(data); err != nil || !got.Equal(b) {
+		t.Fatalf("UnmarshalBinary() = %v, %v, want %v", got, err, b)
+	}
+
+	// Trailing zero words decode to the same BitSet, and an empty encoding to an empty one.
+	if err := got.UnmarshalBinary(append(data, make([]byte, 16)...)); err != nil || !got.Equal(b) {
+		t.Fatalf("UnmarshalBinary() with zero words = %v, %v, want %v", got, err, b)
+	}
+	if err := got.UnmarshalBinary(nil); err != nil || !got.Empty() {
+		t.Fatalf("UnmarshalBinary(nil) = %v, %v, want an empty BitSet", got, err)
+	}
+	if err := got.UnmarshalBinary(data[:7]); !errors.Is(err, ErrInvalidBitSet) {
+		t.Fatalf("UnmarshalBinary() of 7 bytes = %v, want ErrInvalidBitSet", err)
+	}
+
+	for seed := range uint64(50) {
+		a, _, ref, _ := bitSetPair(rand.New(rand.NewPCG(seed, seed)))
+		data, _ := a.MarshalBinary()
+		got := NewBitSet()
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		checkBitSet(t, "UnmarshalBinary", got, ref)
+	}
+}
@@ -0,0 +1,127 @@
+package set
+
+import "sync"
+
+// Concurrent - Concurrent is a Set that is safe for concurrent use by multiple goroutines.
+// Lookups take a read lock, so many goroutines can call Contains at the same time.
+// The zero value is an empty Set ready to use.
+type Concurrent[T comparable] struct {
+	mu sync.RWMutex
+	s  set[T]
+}
+
+// NewConcurrent - Creates a new Concurrent Set.
+func NewConcurrent[T comparable]() *Concurrent[T] {
+	return &Concurrent[T]{s: New[T]()}
+}
+
+// Add - Adds a value to the Set.
+func (c *Concurrent[T]) Add(value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.s.Add(value)
+}
+
+// AddIfAbsent - Adds a value to the Set if it is not already there.
+// It reports whether the value was added, so exactly one of many racing callers wins.
+func (c *Concurrent[T]) AddIfAbsent(value T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	if c.s.Contains(value) {
+		return false
+	}
+	c.s.Add(value)
+	return true
+}
+
+// AddAll - Adds all values to the Set and returns how many of them were not already there.
+func (c *Concurrent[T]) AddAll(values ...T) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	added := 0
+	for _, value := range values {
+		if !c.s.Contains(value) {
+			c.s.Add(value)
+			added++
+		}
+	}
+	return added
+}
+
+// Remove - Removes a value from the Set.
+func (c *Concurrent[T]) Remove(value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s.Remove(value)
+}
+
+// RemoveIfPresent - Removes a value from the Set and reports whether it was there.
+func (c *Concurrent[T]) RemoveIfPresent(value T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.s.Contains(value) {
+		return false
+	}
+	c.s.Remove(value)
+	return true
+}
+
+// Contains - Checks if a value is in the Set.
+func (c *Concurrent[T]) Contains(value T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Contains(value)
+}
+
+// Empty - Checks if the Set is empty.
+func (c *Concurrent[T]) Empty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Empty()
+}
+
+// Len - Returns the size of the Set.
+func (c *Concurrent[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Len()
+}
+
+// Clear - Removes all values from the Set.
+func (c *Concurrent[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s = New[T]()
+}
+
+// Values - Returns a slice of all values in the Set.
+func (c *Concurrent[T]) Values() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Values()
+}
+
+// Snapshot - Returns a copy of the Set that is not shared with other goroutines.
+func (c *Concurrent[T]) Snapshot() set[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Union(New[T]())
+}
+
+// ForEach - Calls a function for each value in the Set while holding a read lock.
+// f must not call methods that modify c.
+func (c *Concurrent[T]) ForEach(f func(T)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	c.s.ForEach(f)
+}
+
+// init - Creates the map of a zero value Concurrent. The caller must hold the write lock.
+func (c *Concurrent[T]) init() {
+	if c.s == nil {
+		c.s = New[T]()
+	}
+}
@@ -0,0 +1,79 @@
+package set
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	goroutines = 8
+	perWorker  = 1000
+)
+
+func TestConcurrentZeroValue(t *testing.T) {
+	var c Concurrent[int]
+	if c.Contains(1) || c.Len() != 0 {
+		t.Fatal("zero value Concurrent is not empty")
+	}
+	c.Add(1)
+	if !c.AddIfAbsent(2) || c.AddAll(2, 3) != 1 || c.Len() != 3 {
+		t.Fatalf("zero value Concurrent holds %v, want [1 2 3]", c.Values())
+	}
+}
+
+func TestConcurrentAddIfAbsent(t *testing.T) {
+	c := NewConcurrent[int]()
+	var won atomic.Int64
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				if c.AddIfAbsent(i) {
+					won.Add(1)
+				}
+				c.Contains(i)
+				c.Len()
+			}
+		}()
+	}
+	wg.Wait()
+	if won.Load() != perWorker || c.Len() != perWorker {
+		t.Fatalf("%d AddIfAbsent calls won and Len() = %d, want %d", won.Load(), c.Len(), perWorker)
+	}
+}
+
+func TestConcurrentAddRemove(t *testing.T) {
+	c := NewConcurrent[int]()
+	var removed atomic.Int64
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			values := make([]int, perWorker)
+			for i := range values {
+				values[i] = g*perWorker + i
+			}
+			c.AddAll(values...)
+		}()
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				if c.RemoveIfPresent(g*perWorker + i) {
+					removed.Add(1)
+				}
+				if i%100 == 0 {
+					c.ForEach(func(int) {})
+					c.Snapshot()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if got := int64(c.Len()) + removed.Load(); got != goroutines*perWorker {
+		t.Fatalf("Len() + removed = %d, want %d", got, goroutines*perWorker)
+	}
+}
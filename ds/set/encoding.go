@@ -0,0 +1,116 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/gobslice"
+)
+
+// A Set encodes as an array of its values: a JSON array for encoding/json and a gob encoded slice for
+// encoding.BinaryMarshaler, which encoding/gob also uses to encode it. MarshalJSON sorts the values, so
+// json.Marshal always gives the same output for the same Set: values of a predeclared ordered type in
+// ascending order and any other values by their JSON encoding. MarshalBinary follows the random map order;
+// MarshalBinarySorted encodes the values in the order given by cmp.
+// An OrderedSet encodes the same way in its own order. Decoding sorts the values by the order of the
+// receiver, so an OrderedSet must come from NewOrdered or NewOrderedFunc; a zero value returns ErrNoOrder.
+
+// MarshalJSON - Encodes a Set as a JSON array, sorted by value or by the JSON encoding of the values.
+func (s set[T]) MarshalJSON() ([]byte, error) {
+	values := s.Values()
+	if sortNatural(values) {
+		return json.Marshal(values)
+	}
+	encoded := make([]json.RawMessage, 0, len(values))
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, data)
+	}
+	slices.SortFunc(encoded, func(a, b json.RawMessage) int { return bytes.Compare(a, b) })
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON - Replaces the values of a Set with a JSON array. Duplicates are dropped.
+func (s *set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.load(values)
+	return nil
+}
+
+// MarshalBinary - Encodes a Set with encoding/gob, in no particular order.
+func (s set[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(s.Values())
+}
+
+// MarshalBinarySorted - Encodes a Set with encoding/gob, sorted by cmp.
+func (s set[T]) MarshalBinarySorted(cmp func(a, b T) int) ([]byte, error) {
+	return gobslice.Marshal(s.SortedFunc(cmp))
+}
+
+// UnmarshalBinary - Replaces the values of a Set with values encoded by MarshalBinary.
+func (s *set[T]) UnmarshalBinary(data []byte) error {
+	values, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	s.load(values)
+	return nil
+}
+
+// load - Replaces the values of a Set, allocating it if needed.
+func (s *set[T]) load(values []T) {
+	if *s == nil {
+		*s = make(set[T], len(values))
+	}
+	s.Clear()
+	for _, value := range values {
+		s.Add(value)
+	}
+}
+
+// MarshalJSON - Encodes an OrderedSet as a JSON array, in the order of the set.
+func (s *OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// UnmarshalJSON - Replaces the values of an OrderedSet with a JSON array. Duplicates are dropped.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	return s.load(values)
+}
+
+// MarshalBinary - Encodes an OrderedSet with encoding/gob, in the order of the set.
+func (s *OrderedSet[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(s.Values())
+}
+
+// UnmarshalBinary - Replaces the values of an OrderedSet with values encoded by MarshalBinary.
+func (s *OrderedSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	return s.load(values)
+}
+
+// load - Replaces the values of an OrderedSet, or returns ErrNoOrder for a zero value OrderedSet.
+func (s *OrderedSet[T]) load(values []T) error {
+	if s.cmp == nil {
+		return ErrNoOrder
+	}
+	s.Clear()
+	for _, value := range values {
+		s.Add(value)
+	}
+	return nil
+}
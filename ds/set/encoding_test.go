@@ -0,0 +1,83 @@
+package set
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/codectest"
+)
+
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0})
+	f.Add([]byte("a set with duplicates"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := codectest.Values(data)
+		s, o := New[int](), NewOrdered[int]()
+		for _, v := range values {
+			s.Add(v)
+			o.Add(v)
+		}
+		want := slices.Compact(slices.Sorted(slices.Values(values)))
+
+		empty := func() *set[int] {
+			s := New[int]()
+			return &s
+		}
+		codectest.RoundTrip(t, "Set", &s, empty, func(s *set[int]) []int { return SortedOrdered(*s) }, want)
+		codectest.RoundTrip(t, "OrderedSet", o, NewOrdered[int], (*OrderedSet[int]).Values, want)
+		// Decoding sorts by the order of the receiver, whatever the order of the encoded values.
+		descending := func() *OrderedSet[int] {
+			return NewOrderedFunc(func(a, b int) int { return cmp.Compare(b, a) })
+		}
+		backward := slices.Clone(want)
+		slices.Reverse(backward)
+		codectest.RoundTrip(t, "descending OrderedSet", o, descending, (*OrderedSet[int]).Values, backward)
+
+		sorted, err := s.MarshalBinarySorted(cmp.Compare[int])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := New[int]()
+		if err := got.UnmarshalBinary(sorted); err != nil || !got.Equal(s) {
+			t.Fatalf("MarshalBinarySorted round trip = %v, %v, want %v", got, err, s)
+		}
+
+		// The JSON encoding of a Set is the same as that of the OrderedSet holding its values.
+		setJSON, _ := json.Marshal(s)
+		orderedJSON, _ := json.Marshal(o)
+		if string(setJSON) != string(orderedJSON) {
+			t.Fatalf("json.Marshal(Set) = %s, want %s", setJSON, orderedJSON)
+		}
+	})
+}
+
+func TestMarshalJSONOrder(t *testing.T) {
+	ints := New[int]()
+	for _, v := range []int{10, -1, 2} {
+		ints.Add(v)
+	}
+	names := New[id]()
+	for _, v := range []id{10, -1, 2} {
+		names.Add(v)
+	}
+	tests := []struct {
+		name string
+		set  any
+		want string
+	}{
+		{"ordered type by value", ints, "[-1,2,10]"},
+		{"named type by encoding", names, "[-1,10,2]"},
+		{"empty", New[string](), "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.set)
+			if err != nil || string(got) != tt.want {
+				t.Fatalf("json.Marshal() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
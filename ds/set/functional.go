@@ -0,0 +1,131 @@
+package set
+
+import "cmp"
+
+// The functions below work across value types, which methods cannot do in Go.
+// A Set has no order, so f is called on the values in no particular order. Zip, Chunk and Window need one,
+// so they take the ascending order of the values, like SortedOrdered. There is no Distinct, since the values
+// of a Set are distinct already.
+
+// MapTo - Returns a new Set with the result of calling f on each value of s.
+// Values that map to the same result are merged. An empty Set maps to an empty Set.
+func MapTo[T, U comparable](s set[T], f func(T) U) set[U] {
+	mapped := make(set[U], len(s))
+	for value := range s {
+		mapped.Add(f(value))
+	}
+	return mapped
+}
+
+// FlatMap - Returns a new Set with all the values returned by calling f on each value of s.
+func FlatMap[T, U comparable](s set[T], f func(T) []U) set[U] {
+	mapped := make(set[U], len(s))
+	for value := range s {
+		for _, u := range f(value) {
+			mapped.Add(u)
+		}
+	}
+	return mapped
+}
+
+// Reduce - Combines the values of s with f, starting from one of them.
+// It returns false for an empty Set. f should be commutative and associative, since the order is random.
+func Reduce[T comparable](s set[T], f func(acc, value T) T) (T, bool) {
+	var acc T
+	first := true
+	for value := range s {
+		if first {
+			acc, first = value, false
+			continue
+		}
+		acc = f(acc, value)
+	}
+	return acc, !first
+}
+
+// Fold - Combines the values of s with f, starting from init. It returns init for an empty Set.
+func Fold[T comparable, U any](s set[T], init U, f func(acc U, value T) U) U {
+	acc := init
+	for value := range s {
+		acc = f(acc, value)
+	}
+	return acc
+}
+
+// Partition - Splits s into the values for which pred returns true and the others.
+// Both Sets are empty for an empty Set.
+func Partition[T comparable](s set[T], pred func(T) bool) (in, out set[T]) {
+	in, out = make(set[T], 0), make(set[T], 0)
+	for value := range s {
+		if pred(value) {
+			in.Add(value)
+		} else {
+			out.Add(value)
+		}
+	}
+	return in, out
+}
+
+// GroupBy - Groups the values of s by the key f returns for them. An empty Set gives an empty map.
+func GroupBy[T, K comparable](s set[T], key func(T) K) map[K]set[T] {
+	groups := make(map[K]set[T])
+	for value := range s {
+		k := key(value)
+		group, ok := groups[k]
+		if !ok {
+			group = make(set[T], 0)
+			groups[k] = group
+		}
+		group.Add(value)
+	}
+	return groups
+}
+
+// Zip - Pairs up the values of a and b in ascending order: the smallest with the smallest and so on.
+// The result has as many Pairs as the smaller Set has values.
+func Zip[T, U cmp.Ordered](a set[T], b set[U]) set[Pair[T, U]] {
+	first, second := SortedOrdered(a), SortedOrdered(b)
+	n := min(len(first), len(second))
+	zipped := make(set[Pair[T, U]], n)
+	for i := range n {
+		zipped.Add(Pair[T, U]{First: first[i], Second: second[i]})
+	}
+	return zipped
+}
+
+// Chunk - Splits the values of s in ascending order into Sets of size consecutive values, the last one
+// possibly smaller. It returns no Sets for an empty Set or if size <= 0.
+func Chunk[T cmp.Ordered](s set[T], size int) []set[T] {
+	if size <= 0 {
+		return []set[T]{}
+	}
+	values := SortedOrdered(s)
+	chunks := make([]set[T], 0, (len(values)+size-1)/size)
+	for i := 0; i < len(values); i += size {
+		chunks = append(chunks, fromValues(values[i:min(i+size, len(values))]))
+	}
+	return chunks
+}
+
+// Window - Returns a Set for every run of size consecutive values of s in ascending order, sliding one
+// value at a time. It returns no Sets if size <= 0 or size > Len().
+func Window[T cmp.Ordered](s set[T], size int) []set[T] {
+	if size <= 0 || size > len(s) {
+		return []set[T]{}
+	}
+	values := SortedOrdered(s)
+	windows := make([]set[T], 0, len(values)-size+1)
+	for i := 0; i+size <= len(values); i++ {
+		windows = append(windows, fromValues(values[i:i+size]))
+	}
+	return windows
+}
+
+// fromValues - Returns a new Set holding values.
+func fromValues[T comparable](values []T) set[T] {
+	s := make(set[T], len(values))
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
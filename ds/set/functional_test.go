@@ -0,0 +1,158 @@
+package set
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// inputs are the empty inputs every function must handle, next to a regular one.
+var inputs = []struct {
+	name   string
+	values set[int]
+}{
+	{"nil", nil},
+	{"empty", of()},
+	{"values", of(3, 1, 4, 5, 9, 2, 6)},
+}
+
+func TestMapToFlatMap(t *testing.T) {
+	tests := map[string]struct {
+		mapped []string
+		flat   []int
+	}{
+		"nil":    {[]string{}, []int{}},
+		"empty":  {[]string{}, []int{}},
+		"values": {[]string{"0", "1", "2"}, []int{1, 2, 3, 4, 5, 6, 9, 10, 20, 30, 40, 50, 60, 90}},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tests[tt.name]
+			// Values that map to the same result are merged.
+			mapped := MapTo(tt.values, func(v int) string { return strconv.Itoa(v % 3) })
+			if mapped == nil || !slices.Equal(SortedOrdered(mapped), want.mapped) {
+				t.Errorf("MapTo() = %#v, want %v", mapped, want.mapped)
+			}
+			flat := FlatMap(tt.values, func(v int) []int { return []int{v, v * 10} })
+			if flat == nil || !slices.Equal(SortedOrdered(flat), want.flat) {
+				t.Errorf("FlatMap() = %#v, want %v", flat, want.flat)
+			}
+		})
+	}
+}
+
+func TestReduceFold(t *testing.T) {
+	tests := map[string]struct {
+		reduce int
+		ok     bool
+		fold   int
+	}{
+		"nil":    {0, false, 100},
+		"empty":  {0, false, 100},
+		"values": {30, true, 130},
+	}
+	sum := func(acc, v int) int { return acc + v }
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tests[tt.name]
+			if got, ok := Reduce(tt.values, sum); got != want.reduce || ok != want.ok {
+				t.Errorf("Reduce() = %d, %v, want %d, %v", got, ok, want.reduce, want.ok)
+			}
+			if got := Fold(tt.values, 100, sum); got != want.fold {
+				t.Errorf("Fold() = %d, want %d", got, want.fold)
+			}
+		})
+	}
+}
+
+func TestPartitionGroupBy(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	tests := map[string]struct {
+		in, out set[int]
+		groups  map[bool]set[int]
+	}{
+		"nil":    {of(), of(), map[bool]set[int]{}},
+		"empty":  {of(), of(), map[bool]set[int]{}},
+		"values": {of(2, 4, 6), of(1, 3, 5, 9), map[bool]set[int]{true: of(2, 4, 6), false: of(1, 3, 5, 9)}},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tests[tt.name]
+			in, out := Partition(tt.values, even)
+			if in == nil || out == nil || !in.Equal(want.in) || !out.Equal(want.out) {
+				t.Errorf("Partition() = %v, %v, want %v, %v", in, out, want.in, want.out)
+			}
+			groups := GroupBy(tt.values, even)
+			if groups == nil || !maps.EqualFunc(groups, want.groups, set[int].Equal) {
+				t.Errorf("GroupBy() = %v, want %v", groups, want.groups)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	letters := New[string]()
+	for _, v := range []string{"c", "a", "b"} {
+		letters.Add(v)
+	}
+	tests := []struct {
+		name string
+		a    set[int]
+		b    set[string]
+		want []Pair[int, string]
+	}{
+		{"nil", nil, nil, []Pair[int, string]{}},
+		{"nil a", nil, letters, []Pair[int, string]{}},
+		{"empty b", of(1), New[string](), []Pair[int, string]{}},
+		{"a smaller", of(20, 10), letters, []Pair[int, string]{{10, "a"}, {20, "b"}}},
+		{"b smaller", of(40, 30, 20, 10), letters, []Pair[int, string]{{10, "a"}, {20, "b"}, {30, "c"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Zip(tt.a, tt.b)
+			if got == nil || len(got) != len(tt.want) {
+				t.Fatalf("Zip() = %#v, want %v", got, tt.want)
+			}
+			for _, pair := range tt.want {
+				if !got.Contains(pair) {
+					t.Fatalf("Zip() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestChunkWindow(t *testing.T) {
+	values := of(5, 3, 1, 4, 2)
+	tests := []struct {
+		name          string
+		values        set[int]
+		size          int
+		chunk, window [][]int
+	}{
+		{"nil", nil, 2, [][]int{}, [][]int{}},
+		{"empty", of(), 1, [][]int{}, [][]int{}},
+		{"negative size", values, -1, [][]int{}, [][]int{}},
+		{"zero size", values, 0, [][]int{}, [][]int{}},
+		{"size 1", values, 1, [][]int{{1}, {2}, {3}, {4}, {5}}, [][]int{{1}, {2}, {3}, {4}, {5}}},
+		{"size 2", values, 2, [][]int{{1, 2}, {3, 4}, {5}}, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{"size len", values, 5, [][]int{{1, 2, 3, 4, 5}}, [][]int{{1, 2, 3, 4, 5}}},
+		{"size > len", values, 6, [][]int{{1, 2, 3, 4, 5}}, [][]int{}},
+	}
+	equal := func(got []set[int], want [][]int) bool {
+		return slices.EqualFunc(got, want, func(s set[int], values []int) bool {
+			return slices.Equal(SortedOrdered(s), values)
+		})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chunk(tt.values, tt.size); got == nil || !equal(got, tt.chunk) {
+				t.Errorf("Chunk(%d) = %v, want %v", tt.size, got, tt.chunk)
+			}
+			if got := Window(tt.values, tt.size); got == nil || !equal(got, tt.window) {
+				t.Errorf("Window(%d) = %v, want %v", tt.size, got, tt.window)
+			}
+		})
+	}
+}
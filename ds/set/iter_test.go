@@ -0,0 +1,32 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+func TestIterators(t *testing.T) {
+	for _, values := range [][]uint{nil, {7}, {1, 3, 64, 65, 200}} {
+		s, o, b := New[uint](), NewOrdered[uint](), NewBitSet()
+		backward := make([]uint, len(values))
+		for i, v := range values {
+			s.Add(v)
+			o.Add(v)
+			b.Add(v)
+			backward[len(values)-1-i] = v
+		}
+		itertest.Unordered(t, "Set.All", s.All(), values)
+		itertest.Seq(t, "OrderedSet.All", o.All(), values)
+		itertest.Seq(t, "OrderedSet.Backward", o.Backward(), backward)
+		itertest.Seq(t, "BitSet.All", b.All(), values)
+
+		var inRange []uint
+		for _, v := range values {
+			if v >= 3 && v <= 65 {
+				inRange = append(inRange, v)
+			}
+		}
+		itertest.Seq(t, "OrderedSet.Range", o.Range(3, 65), inRange)
+	}
+}
@@ -0,0 +1,216 @@
+package set
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/rama-kairi/ds-algo/ds/tree/treemap"
+)
+
+// OrderedSet - OrderedSet is a Set that keeps its values sorted, so iteration, Values and String
+// always follow the same order. It is the AVL tree of package treemap with empty values, where each node
+// also stores the size of its subtree, which makes Add, Remove, Contains, Floor, Ceiling, Rank and Select O(log n).
+// An OrderedSet needs an order, so it must be created with NewOrdered or NewOrderedFunc. The zero value reads
+// as an empty set, but Add panics with ErrNoOrder, like a write to a nil map, and decoding into it returns ErrNoOrder.
+type OrderedSet[T any] struct {
+	tree treemap.AVL[T, struct{}]
+	cmp  func(a, b T) int
+}
+
+// ErrNoOrder is returned when values are added to an OrderedSet that was not created by NewOrdered or NewOrderedFunc.
+var ErrNoOrder = errors.New("set: OrderedSet without an order, use NewOrdered or NewOrderedFunc")
+
+// NewOrdered - Creates a new OrderedSet sorted by the natural order of T.
+func NewOrdered[T cmp.Ordered]() *OrderedSet[T] {
+	return NewOrderedFunc(cmp.Compare[T])
+}
+
+// NewOrderedFunc - Creates a new OrderedSet sorted by cmp, which returns a negative number when a < b,
+// a positive number when a > b and zero when a and b are the same value.
+func NewOrderedFunc[T any](cmp func(a, b T) int) *OrderedSet[T] {
+	return &OrderedSet[T]{tree: *treemap.NewAVLFunc[T, struct{}](cmp), cmp: cmp}
+}
+
+// Add - Adds a value to the OrderedSet. It panics with ErrNoOrder on a zero value OrderedSet.
+func (s *OrderedSet[T]) Add(value T) {
+	if s.cmp == nil {
+		panic(ErrNoOrder)
+	}
+	s.tree.Put(value, struct{}{})
+}
+
+// Remove - Removes a value from the OrderedSet.
+func (s *OrderedSet[T]) Remove(value T) {
+	s.tree.Delete(value)
+}
+
+// Contains - Checks if a value is in the OrderedSet.
+func (s *OrderedSet[T]) Contains(value T) bool {
+	return s.tree.Contains(value)
+}
+
+// Empty - Checks if the OrderedSet is empty.
+func (s *OrderedSet[T]) Empty() bool {
+	return s.tree.IsEmpty()
+}
+
+// Len - Returns the size of the OrderedSet.
+func (s *OrderedSet[T]) Len() int {
+	return s.tree.Len()
+}
+
+// Clear - Removes all values from the OrderedSet.
+func (s *OrderedSet[T]) Clear() {
+	s.tree.Clear()
+}
+
+// Min - Returns the smallest value, false if the OrderedSet is empty.
+func (s *OrderedSet[T]) Min() (T, bool) {
+	value, _, ok := s.tree.Min()
+	return value, ok
+}
+
+// Max - Returns the largest value, false if the OrderedSet is empty.
+func (s *OrderedSet[T]) Max() (T, bool) {
+	value, _, ok := s.tree.Max()
+	return value, ok
+}
+
+// Floor - Returns the largest value less than or equal to value, false if there is none.
+func (s *OrderedSet[T]) Floor(value T) (T, bool) {
+	floor, _, ok := s.tree.Floor(value)
+	return floor, ok
+}
+
+// Ceiling - Returns the smallest value greater than or equal to value, false if there is none.
+func (s *OrderedSet[T]) Ceiling(value T) (T, bool) {
+	ceiling, _, ok := s.tree.Ceiling(value)
+	return ceiling, ok
+}
+
+// Rank - Returns the number of values in the OrderedSet that are less than value.
+func (s *OrderedSet[T]) Rank(value T) int {
+	return s.tree.Rank(value)
+}
+
+// Select - Returns the value with the given rank, i.e. the k-th smallest value counting from 0.
+// It returns false if k is not in [0, Len()).
+func (s *OrderedSet[T]) Select(k int) (T, bool) {
+	value, _, ok := s.tree.Select(k)
+	return value, ok
+}
+
+// Range - Returns an iterator over the values between lo and hi, both included, in ascending order.
+func (s *OrderedSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return values(s.tree.Range(lo, hi))
+}
+
+// All - Returns an iterator over the values in ascending order.
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return values(s.tree.All())
+}
+
+// Backward - Returns an iterator over the values in descending order.
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return values(s.tree.Backward())
+}
+
+// Values - Returns a slice of all values in ascending order.
+func (s *OrderedSet[T]) Values() []T {
+	return s.tree.Keys()
+}
+
+// ForEach - Calls a function for each value in ascending order.
+func (s *OrderedSet[T]) ForEach(f func(T)) {
+	for value := range s.All() {
+		f(value)
+	}
+}
+
+// Filter - Returns a new OrderedSet with the values for which f returns true.
+func (s *OrderedSet[T]) Filter(f func(T) bool) *OrderedSet[T] {
+	return s.build(s.filter(f))
+}
+
+// The set algebra below looks values of s up in other, so other may be sorted by another order as long as
+// both orders agree on which values are equal. The result is sorted by the order of s.
+
+// Union - Returns a new OrderedSet that is the union of two OrderedSets.
+func (s *OrderedSet[T]) Union(other *OrderedSet[T]) *OrderedSet[T] {
+	union := s.build(s.All())
+	for value := range other.All() {
+		union.Add(value)
+	}
+	return union
+}
+
+// Intersection - Returns a new OrderedSet that is the intersection of two OrderedSets.
+func (s *OrderedSet[T]) Intersection(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.build(s.filter(other.Contains))
+}
+
+// Difference - Returns a new OrderedSet with the values of s that are not in other.
+func (s *OrderedSet[T]) Difference(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.build(s.filter(func(value T) bool { return !other.Contains(value) }))
+}
+
+// Subset - Checks if s is a subset of other.
+func (s *OrderedSet[T]) Subset(other *OrderedSet[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for value := range s.All() {
+		if !other.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal - Checks if two OrderedSets hold the same values.
+func (s *OrderedSet[T]) Equal(other *OrderedSet[T]) bool {
+	return s.Len() == other.Len() && s.Subset(other)
+}
+
+// String - Returns a string representation of the OrderedSet, in ascending order.
+func (s *OrderedSet[T]) String() string {
+	values := make([]string, 0, s.Len())
+	for value := range s.All() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	return "{" + strings.Join(values, ", ") + "}"
+}
+
+// build - Returns a new OrderedSet with the same order as s holding values.
+func (s *OrderedSet[T]) build(values iter.Seq[T]) *OrderedSet[T] {
+	built := NewOrderedFunc(s.cmp)
+	for value := range values {
+		built.Add(value)
+	}
+	return built
+}
+
+// filter - Returns an iterator over the values of s for which keep returns true, in ascending order.
+func (s *OrderedSet[T]) filter(keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range s.All() {
+			if keep(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// values - Returns an iterator over the keys of a tree whose values are empty.
+func values[T any](entries iter.Seq2[T, struct{}]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range entries {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package set
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestOrderedSetZeroValue(t *testing.T) {
+	var s OrderedSet[int]
+	if s.Len() != 0 || !s.Empty() || s.Contains(1) || len(s.Values()) != 0 || s.Rank(5) != 0 {
+		t.Fatal("zero value OrderedSet does not read as empty")
+	}
+	if _, ok := s.Min(); ok {
+		t.Fatal("Min() on a zero value OrderedSet = true")
+	}
+	if _, ok := s.Floor(1); ok {
+		t.Fatal("Floor() on a zero value OrderedSet = true")
+	}
+	s.Remove(1)
+	if err := s.UnmarshalJSON([]byte("[1]")); !errors.Is(err, ErrNoOrder) {
+		t.Fatalf("UnmarshalJSON() into a zero value OrderedSet = %v, want ErrNoOrder", err)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrNoOrder) {
+			t.Fatalf("Add() on a zero value OrderedSet panicked with %v, want ErrNoOrder", err)
+		}
+	}()
+	s.Add(1)
+}
+
+// TestOrderedSetRandomized adds and removes random values and checks the tree and every query against a
+// sorted slice after each step.
+func TestOrderedSetRandomized(t *testing.T) {
+	for seed := range uint64(3) {
+		t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+			r := rand.New(rand.NewPCG(seed, seed))
+			s, ref := NewOrdered[int](), []int{}
+			for step := range 3000 {
+				v := r.IntN(200)
+				i, found := slices.BinarySearch(ref, v)
+				if r.IntN(3) == 0 {
+					s.Remove(v)
+					if found {
+						ref = slices.Delete(ref, i, i+1)
+					}
+				} else {
+					s.Add(v)
+					if !found {
+						ref = slices.Insert(ref, i, v)
+					}
+				}
+				if err := s.tree.Check(); err != nil {
+					t.Fatalf("step %d: %v", step, err)
+				}
+				compareOrdered(t, s, ref, r)
+			}
+		})
+	}
+}
+
+// TestOrderedSetSortedInput removes every other value of a set built from sorted input, which rebalances
+// the tree at every level.
+func TestOrderedSetSortedInput(t *testing.T) {
+	s, ref := NewOrdered[int](), []int{}
+	for v := range 1000 {
+		s.Add(v)
+	}
+	for v := range 1000 {
+		if v%2 == 0 {
+			s.Remove(v)
+		} else {
+			ref = append(ref, v)
+		}
+		if err := s.tree.Check(); err != nil {
+			t.Fatalf("after Remove(%d): %v", v, err)
+		}
+	}
+	compareOrdered(t, s, ref, rand.New(rand.NewPCG(1, 1)))
+	if h := s.tree.Height(); h > 15 {
+		t.Fatalf("Height() = %d for %d values, want at most 15", h, s.Len())
+	}
+}
+
+// compareOrdered checks the values and queries of s against ref, its values in ascending order.
+func compareOrdered(t *testing.T, s *OrderedSet[int], ref []int, r *rand.Rand) {
+	t.Helper()
+	if got := s.Values(); !slices.Equal(got, ref) || s.Len() != len(ref) {
+		t.Fatalf("Values() = %v, Len() = %d, want %v", got, s.Len(), ref)
+	}
+	backward := slices.Clone(ref)
+	slices.Reverse(backward)
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, backward) {
+		t.Fatalf("Backward() = %v, want %v", got, backward)
+	}
+	minimum, ok := s.Min()
+	if ok != (len(ref) > 0) || ok && minimum != ref[0] {
+		t.Fatalf("Min() = %d, %v, want the first of %v", minimum, ok, ref)
+	}
+	maximum, ok := s.Max()
+	if ok != (len(ref) > 0) || ok && maximum != ref[len(ref)-1] {
+		t.Fatalf("Max() = %d, %v, want the last of %v", maximum, ok, ref)
+	}
+	for range 5 {
+		v := r.IntN(202) - 1
+		i, found := slices.BinarySearch(ref, v)
+		if got := s.Contains(v); got != found {
+			t.Fatalf("Contains(%d) = %v, want %v", v, got, found)
+		}
+		if got := s.Rank(v); got != i {
+			t.Fatalf("Rank(%d) = %d, want %d", v, got, i)
+		}
+		floor, ok := s.Floor(v)
+		switch {
+		case found && (!ok || floor != v), !found && i > 0 && (!ok || floor != ref[i-1]), !found && i == 0 && ok:
+			t.Fatalf("Floor(%d) = %d, %v in %v", v, floor, ok, ref)
+		}
+		ceiling, ok := s.Ceiling(v)
+		if ok != (i < len(ref)) || ok && ceiling != ref[i] {
+			t.Fatalf("Ceiling(%d) = %d, %v in %v", v, ceiling, ok, ref)
+		}
+		hi := v + r.IntN(40)
+		j, _ := slices.BinarySearch(ref, hi+1)
+		if got := slices.Collect(s.Range(v, hi)); !slices.Equal(got, ref[i:j]) {
+			t.Fatalf("Range(%d, %d) = %v, want %v", v, hi, got, ref[i:j])
+		}
+	}
+	for k := -1; k <= len(ref); k++ {
+		got, ok := s.Select(k)
+		if ok != (k >= 0 && k < len(ref)) || ok && got != ref[k] {
+			t.Fatalf("Select(%d) = %d, %v in %v", k, got, ok, ref)
+		}
+	}
+}
+
+func TestOrderedSetAlgebra(t *testing.T) {
+	descending := func(a, b int) int { return cmp.Compare(b, a) }
+	tests := []struct {
+		name  string
+		a, b  []int
+		other func(a, b int) int
+	}{
+		{"empty", nil, nil, cmp.Compare[int]},
+		{"empty other", []int{1, 2, 3}, nil, cmp.Compare[int]},
+		{"empty s", nil, []int{1, 2, 3}, cmp.Compare[int]},
+		{"disjoint", []int{1, 3, 5}, []int{0, 2, 4, 6}, cmp.Compare[int]},
+		{"overlapping", []int{1, 2, 3, 4}, []int{3, 4, 5}, cmp.Compare[int]},
+		{"subset", []int{2, 3}, []int{1, 2, 3, 4}, cmp.Compare[int]},
+		{"equal", []int{-1, 0, 1}, []int{1, 0, -1}, cmp.Compare[int]},
+		{"other in another order", []int{1, 2, 3, 4}, []int{3, 4, 5}, descending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewOrdered[int](), NewOrderedFunc(tt.other)
+			refA, refB := New[int](), New[int]()
+			for _, v := range tt.a {
+				a.Add(v)
+				refA.Add(v)
+			}
+			for _, v := range tt.b {
+				b.Add(v)
+				refB.Add(v)
+			}
+			results := []struct {
+				op   string
+				got  *OrderedSet[int]
+				want set[int]
+			}{
+				{"Union", a.Union(b), refA.Union(refB)},
+				{"Intersection", a.Intersection(b), refA.Intersection(refB)},
+				{"Difference", a.Difference(b), refA.Difference(refB)},
+			}
+			for _, res := range results {
+				if err := res.got.tree.Check(); err != nil {
+					t.Fatalf("%s: %v", res.op, err)
+				}
+				if got, want := res.got.Values(), SortedOrdered(res.want); !slices.Equal(got, want) {
+					t.Fatalf("%s = %v, want %v", res.op, got, want)
+				}
+			}
+			if got, want := a.Subset(b), refA.Subset(refB); got != want {
+				t.Fatalf("Subset() = %v, want %v", got, want)
+			}
+			if got, want := a.Equal(b), refA.Equal(refB); got != want {
+				t.Fatalf("Equal() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package set
 
 import (
+	"cmp"
 	"fmt"
+	"iter"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -106,14 +109,75 @@ func (s set[T]) Values() []T {
 	return values
 }
 
-// String - Returns a string representation of a Set.
-func (s set[T]) String() {
-	sets := make([]string, 0, len(s))
-	for value := range s {
-		sets = append(sets, fmt.Sprintf("%v", value))
+// String - Returns a string representation of a Set, with the values in the order of join.
+func (s set[T]) String() string {
+	return s.join("%v")
+}
+
+// Format - Implements fmt.Formatter. %v prints the values in braces, %+v adds the size,
+// %#v prints the Set as a Go map literal and any other verb is applied to each value.
+func (s set[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%#v", map[T]struct{}(s))
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{size:%d values:%s}", len(s), s.join("%+v"))
+	default:
+		fmt.Fprint(f, s.join(fmt.FormatString(f, verb)))
 	}
+}
 
-	fmt.Println("{" + strings.Join(sets, ", ") + "}")
+// join - Formats each value of a Set with format and joins them in braces. Values of a predeclared
+// ordered type come out in ascending order, any other values are sorted by their formatted text.
+func (s set[T]) join(format string) string {
+	values := s.Values()
+	natural := sortNatural(values)
+	sets := make([]string, 0, len(values))
+	for _, value := range values {
+		sets = append(sets, fmt.Sprintf(format, value))
+	}
+	if !natural {
+		slices.Sort(sets)
+	}
+	return "{" + strings.Join(sets, ", ") + "}"
+}
+
+// sortNatural - Sorts values in ascending order if T is one of the predeclared ordered types and
+// reports whether it did. Values of any other type, including named ordered types, are left as they are.
+func sortNatural[T any](values []T) bool {
+	switch v := any(values).(type) {
+	case []int:
+		slices.Sort(v)
+	case []int8:
+		slices.Sort(v)
+	case []int16:
+		slices.Sort(v)
+	case []int32:
+		slices.Sort(v)
+	case []int64:
+		slices.Sort(v)
+	case []uint:
+		slices.Sort(v)
+	case []uint8:
+		slices.Sort(v)
+	case []uint16:
+		slices.Sort(v)
+	case []uint32:
+		slices.Sort(v)
+	case []uint64:
+		slices.Sort(v)
+	case []uintptr:
+		slices.Sort(v)
+	case []float32:
+		slices.Sort(v)
+	case []float64:
+		slices.Sort(v)
+	case []string:
+		slices.Sort(v)
+	default:
+		return false
+	}
+	return true
 }
 
 // ForEach - Calls a function for each value in a Set.
@@ -123,6 +187,17 @@ func (s set[T]) ForEach(f func(T)) {
 	}
 }
 
+// All - Returns an iterator over the values in a Set, in no particular order.
+func (s set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range s {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // Map - Returns a new Set that is the result of calling a function on each value in a Set.
 func (s set[T]) Map(f func(T) T) set[T] {
 	mapped := make(set[T], 0)
@@ -143,11 +218,27 @@ func (s set[T]) Filter(f func(T) bool) set[T] {
 	return filtered
 }
 
-// Sort - Sorts a Set.
-func (s set[T]) Sort(less func(T, T) bool) []T {
+// Sorted - Returns a slice of all values in a Set, sorted by less.
+// Values that are neither less than nor greater than each other come out in no particular order.
+func (s set[T]) Sorted(less func(a, b T) bool) []T {
 	values := s.Values()
-	sort.Slice(s.Values(), func(i, j int) bool {
-		return less(s.Values()[i], s.Values()[j])
+	sort.Slice(values, func(i, j int) bool {
+		return less(values[i], values[j])
 	})
 	return values
 }
+
+// SortedFunc - Returns a slice of all values in a Set, sorted by cmp, which returns a negative
+// number when a < b, a positive number when a > b and zero otherwise.
+func (s set[T]) SortedFunc(cmp func(a, b T) int) []T {
+	values := s.Values()
+	slices.SortFunc(values, cmp)
+	return values
+}
+
+// SortedOrdered - Returns a slice of all values in a Set in ascending order.
+func SortedOrdered[T cmp.Ordered](s set[T]) []T {
+	values := s.Values()
+	slices.Sort(values)
+	return values
+}
@@ -0,0 +1,106 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSorted(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"empty", nil, []int{}},
+		{"single", []int{7}, []int{7}},
+		{"already sorted", []int{-3, 0, 1, 2, 10}, []int{-3, 0, 1, 2, 10}},
+		{"reverse sorted", []int{10, 2, 1, 0, -3}, []int{-3, 0, 1, 2, 10}},
+		{"duplicates", []int{3, 1, 3, 2, 1}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New[int]()
+			for _, v := range tt.values {
+				s.Add(v)
+			}
+			results := map[string][]int{
+				"Sorted":        s.Sorted(func(a, b int) bool { return a < b }),
+				"SortedFunc":    s.SortedFunc(cmp.Compare[int]),
+				"SortedOrdered": SortedOrdered(s),
+			}
+			for name, got := range results {
+				if !slices.Equal(got, tt.want) {
+					t.Errorf("%s() = %v, want %v", name, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSortedCustomComparator(t *testing.T) {
+	s := New[string]()
+	for _, v := range []string{"kiwi", "Apple", "fig", "banana"} {
+		s.Add(v)
+	}
+	byLength := func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+	}
+	want := []string{"fig", "kiwi", "Apple", "banana"}
+	if got := s.SortedFunc(byLength); !slices.Equal(got, want) {
+		t.Errorf("SortedFunc(byLength) = %v, want %v", got, want)
+	}
+	descending := func(a, b string) bool { return strings.ToLower(a) > strings.ToLower(b) }
+	want = []string{"kiwi", "fig", "banana", "Apple"}
+	if got := s.Sorted(descending); !slices.Equal(got, want) {
+		t.Errorf("Sorted(descending) = %v, want %v", got, want)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	ints := New[int]()
+	for _, v := range []int{10, -1, 2, 0} {
+		ints.Add(v)
+	}
+	tests := []struct {
+		name   string
+		format string
+		set    any
+		want   string
+	}{
+		{"ints", "%v", ints, "{-1, 0, 2, 10}"},
+		{"ints", "%+v", ints, "{size:4 values:{-1, 0, 2, 10}}"},
+		{"ints", "%#v", ints, "map[int]struct {}{-1:struct {}{}, 0:struct {}{}, 2:struct {}{}, 10:struct {}{}}"},
+		{"ints", "%03d", ints, "{-01, 000, 002, 010}"},
+		{"empty", "%v", New[string](), "{}"},
+		{"empty", "%#v", New[string](), "map[string]struct {}{}"},
+		// A named type is not sorted by value, so its formatted text decides the order.
+		{"named", "%v", namedSet(10, -1, 2), "{-1, 10, 2}"},
+		{"named", "%x", namedSet(10, -1, 2), "{-1, 2, a}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Format many times, so that a dependence on the map order would show.
+			for range 20 {
+				if got := fmt.Sprintf(tt.format, tt.set); got != tt.want {
+					t.Fatalf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+				}
+			}
+		})
+	}
+	if got := ints.String(); got != "{-1, 0, 2, 10}" {
+		t.Errorf("String() = %q, want %q", got, "{-1, 0, 2, 10}")
+	}
+}
+
+type id int
+
+func namedSet(values ...id) set[id] {
+	s := New[id]()
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
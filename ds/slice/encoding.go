@@ -0,0 +1,40 @@
+package slice
+
+import (
+	"encoding/json"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/gobslice"
+)
+
+// MarshalJSON - encode the Slice as a JSON array
+func (s slice[T]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]T(s))
+}
+
+// UnmarshalJSON - replace the values of the Slice with a JSON array
+func (s *slice[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*s = append((*s)[:0], values...)
+	return nil
+}
+
+// MarshalBinary - encode the Slice with encoding/gob, which also uses it to encode the Slice
+func (s slice[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal([]T(s))
+}
+
+// UnmarshalBinary - replace the values of the Slice with values encoded by MarshalBinary
+func (s *slice[T]) UnmarshalBinary(data []byte) error {
+	values, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	*s = append((*s)[:0], values...)
+	return nil
+}
@@ -0,0 +1,26 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/codectest"
+)
+
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0})
+	f.Add([]byte("a slice"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		values := codectest.Values(data)
+		s := New[int]()
+		for _, v := range values {
+			s = s.Append(v)
+		}
+
+		empty := func() *slice[int] {
+			s := New[int]()
+			return &s
+		}
+		codectest.RoundTrip(t, "Slice", &s, empty, func(s *slice[int]) []int { return *s }, values)
+	})
+}
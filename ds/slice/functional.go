@@ -0,0 +1,125 @@
+package slice
+
+// The functions below work across value types, which methods cannot do in Go.
+// They never modify their input and return empty, non-nil results for empty input.
+
+// Pair - an element of the Slice returned by Zip
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Map - return a new Slice with the result of calling f on each value
+func Map[T, U any](s slice[T], f func(T) U) slice[U] {
+	mapped := make(slice[U], 0, len(s))
+	for _, v := range s {
+		mapped = append(mapped, f(v))
+	}
+	return mapped
+}
+
+// FlatMap - return a new Slice with all the values returned by calling f on each value, in order
+func FlatMap[T, U any](s slice[T], f func(T) []U) slice[U] {
+	mapped := make(slice[U], 0, len(s))
+	for _, v := range s {
+		mapped = append(mapped, f(v)...)
+	}
+	return mapped
+}
+
+// Reduce - combine the values from first to last with f, starting from the first value;
+// false for an empty Slice
+func Reduce[T any](s slice[T], f func(acc, v T) T) (T, bool) {
+	if len(s) == 0 {
+		var empty T
+		return empty, false
+	}
+	acc := s[0]
+	for _, v := range s[1:] {
+		acc = f(acc, v)
+	}
+	return acc, true
+}
+
+// Fold - combine the values from first to last with f, starting from init; init for an empty Slice
+func Fold[T, U any](s slice[T], init U, f func(acc U, v T) U) U {
+	acc := init
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Partition - split the values into those for which pred returns true and the others, keeping their order
+func Partition[T any](s slice[T], pred func(T) bool) (in, out slice[T]) {
+	in, out = make(slice[T], 0), make(slice[T], 0)
+	for _, v := range s {
+		if pred(v) {
+			in = append(in, v)
+		} else {
+			out = append(out, v)
+		}
+	}
+	return in, out
+}
+
+// GroupBy - group the values by the key f returns for them, keeping their order within each group
+func GroupBy[T any, K comparable](s slice[T], key func(T) K) map[K]slice[T] {
+	groups := make(map[K]slice[T])
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Zip - pair up the values of a and b by index; the result is as long as the shorter of the two
+func Zip[T, U any](a slice[T], b slice[U]) slice[Pair[T, U]] {
+	n := min(len(a), len(b))
+	zipped := make(slice[Pair[T, U]], n)
+	for i := range n {
+		zipped[i] = Pair[T, U]{First: a[i], Second: b[i]}
+	}
+	return zipped
+}
+
+// Chunk - split the Slice into consecutive chunks of size values, the last one possibly shorter;
+// empty if size <= 0. The chunks share memory with s but appending to one never overwrites another.
+func Chunk[T any](s slice[T], size int) []slice[T] {
+	if size <= 0 {
+		return []slice[T]{}
+	}
+	chunks := make([]slice[T], 0, (len(s)+size-1)/size)
+	for i := 0; i < len(s); i += size {
+		end := min(i+size, len(s))
+		chunks = append(chunks, s[i:end:end])
+	}
+	return chunks
+}
+
+// Window - return every run of size consecutive values, sliding one value at a time;
+// empty if size <= 0 or size > len(s). The windows share memory with s.
+func Window[T any](s slice[T], size int) []slice[T] {
+	if size <= 0 || size > len(s) {
+		return []slice[T]{}
+	}
+	windows := make([]slice[T], 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		windows = append(windows, s[i:i+size:i+size])
+	}
+	return windows
+}
+
+// Distinct - return a new Slice without repeated values, keeping the first occurrence of each
+func Distinct[T comparable](s slice[T]) slice[T] {
+	seen := make(map[T]struct{}, len(s))
+	distinct := make(slice[T], 0, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		distinct = append(distinct, v)
+	}
+	return distinct
+}
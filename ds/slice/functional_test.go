@@ -0,0 +1,175 @@
+package slice
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// inputs are the empty inputs every function must handle, next to a regular one.
+var inputs = []struct {
+	name   string
+	values slice[int]
+}{
+	{"nil", nil},
+	{"empty", slice[int]{}},
+	{"values", slice[int]{3, 1, 4, 1, 5, 9, 2, 6}},
+}
+
+func TestMapFlatMap(t *testing.T) {
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			mapped := Map(tt.values, strconv.Itoa)
+			want := []string{}
+			for _, v := range tt.values {
+				want = append(want, strconv.Itoa(v))
+			}
+			if mapped == nil || !slices.Equal(mapped, want) {
+				t.Fatalf("Map() = %#v, want %#v", mapped, want)
+			}
+
+			flat := FlatMap(tt.values, func(v int) []int { return slices.Repeat([]int{v}, v%3) })
+			wantFlat := []int{}
+			for _, v := range tt.values {
+				wantFlat = append(wantFlat, slices.Repeat([]int{v}, v%3)...)
+			}
+			if flat == nil || !slices.Equal(flat, wantFlat) {
+				t.Fatalf("FlatMap() = %#v, want %#v", flat, wantFlat)
+			}
+		})
+	}
+}
+
+func TestReduceFold(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	digits := func(acc string, v int) string { return acc + strconv.Itoa(v) }
+	tests := map[string]struct {
+		reduce int
+		ok     bool
+		fold   string
+	}{
+		"nil":    {0, false, ">"},
+		"empty":  {0, false, ">"},
+		"values": {31, true, ">31415926"},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tests[tt.name]
+			if got, ok := Reduce(tt.values, sum); got != want.reduce || ok != want.ok {
+				t.Errorf("Reduce() = %d, %v, want %d, %v", got, ok, want.reduce, want.ok)
+			}
+			if got := Fold(tt.values, ">", digits); got != want.fold {
+				t.Errorf("Fold() = %q, want %q", got, want.fold)
+			}
+		})
+	}
+	// Reduce starts from the first value, so a function that is not commutative sees them in order.
+	if got, _ := Reduce(slice[int]{1, 2, 3}, func(acc, v int) int { return acc*10 + v }); got != 123 {
+		t.Errorf("Reduce() = %d, want 123", got)
+	}
+}
+
+func TestPartitionGroupBy(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	tests := map[string]struct {
+		in, out slice[int]
+		groups  map[bool]slice[int]
+	}{
+		"nil":    {slice[int]{}, slice[int]{}, map[bool]slice[int]{}},
+		"empty":  {slice[int]{}, slice[int]{}, map[bool]slice[int]{}},
+		"values": {slice[int]{4, 2, 6}, slice[int]{3, 1, 1, 5, 9}, map[bool]slice[int]{true: {4, 2, 6}, false: {3, 1, 1, 5, 9}}},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tests[tt.name]
+			in, out := Partition(tt.values, even)
+			if in == nil || out == nil || !slices.Equal(in, want.in) || !slices.Equal(out, want.out) {
+				t.Errorf("Partition() = %#v, %#v, want %v, %v", in, out, want.in, want.out)
+			}
+			groups := GroupBy(tt.values, even)
+			if groups == nil || !maps.EqualFunc(groups, want.groups, slices.Equal) {
+				t.Errorf("GroupBy() = %v, want %v", groups, want.groups)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	letters := slice[string]{"a", "b", "c"}
+	tests := []struct {
+		name string
+		a    slice[int]
+		b    slice[string]
+		want slice[Pair[int, string]]
+	}{
+		{"nil", nil, nil, slice[Pair[int, string]]{}},
+		{"nil a", nil, letters, slice[Pair[int, string]]{}},
+		{"empty b", slice[int]{1}, slice[string]{}, slice[Pair[int, string]]{}},
+		{"a shorter", slice[int]{1, 2}, letters, slice[Pair[int, string]]{{1, "a"}, {2, "b"}}},
+		{"b shorter", slice[int]{1, 2, 3, 4}, letters, slice[Pair[int, string]]{{1, "a"}, {2, "b"}, {3, "c"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Zip(tt.a, tt.b); got == nil || !slices.Equal(got, tt.want) {
+				t.Fatalf("Zip() = %#v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkWindow(t *testing.T) {
+	values := slice[int]{1, 2, 3, 4, 5}
+	tests := []struct {
+		name          string
+		values        slice[int]
+		size          int
+		chunk, window [][]int
+	}{
+		{"nil", nil, 2, [][]int{}, [][]int{}},
+		{"empty", slice[int]{}, 1, [][]int{}, [][]int{}},
+		{"negative size", values, -1, [][]int{}, [][]int{}},
+		{"zero size", values, 0, [][]int{}, [][]int{}},
+		{"size 1", values, 1, [][]int{{1}, {2}, {3}, {4}, {5}}, [][]int{{1}, {2}, {3}, {4}, {5}}},
+		{"size 2", values, 2, [][]int{{1, 2}, {3, 4}, {5}}, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{"size len", values, 5, [][]int{{1, 2, 3, 4, 5}}, [][]int{{1, 2, 3, 4, 5}}},
+		{"size > len", values, 6, [][]int{{1, 2, 3, 4, 5}}, [][]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chunk(tt.values, tt.size); got == nil || !equalRuns(got, tt.chunk) {
+				t.Errorf("Chunk(%d) = %v, want %v", tt.size, got, tt.chunk)
+			}
+			if got := Window(tt.values, tt.size); got == nil || !equalRuns(got, tt.window) {
+				t.Errorf("Window(%d) = %v, want %v", tt.size, got, tt.window)
+			}
+		})
+	}
+
+	// Appending to a chunk does not overwrite the next one.
+	chunks := Chunk(slice[int]{1, 2, 3, 4}, 2)
+	_ = append(chunks[0], 9)
+	if !slices.Equal(chunks[1], slice[int]{3, 4}) {
+		t.Fatalf("appending to the first chunk changed the second to %v", chunks[1])
+	}
+}
+
+// equalRuns reports whether the chunks or windows got hold the values of want.
+func equalRuns(got []slice[int], want [][]int) bool {
+	return slices.EqualFunc(got, want, func(a slice[int], b []int) bool { return slices.Equal(a, b) })
+}
+
+func TestDistinct(t *testing.T) {
+	tests := map[string]slice[int]{
+		"nil":    {},
+		"empty":  {},
+		"values": {3, 1, 4, 5, 9, 2, 6},
+	}
+	for _, tt := range inputs {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distinct(tt.values); got == nil || !slices.Equal(got, tests[tt.name]) {
+				t.Fatalf("Distinct() = %#v, want %v", got, tests[tt.name])
+			}
+		})
+	}
+}
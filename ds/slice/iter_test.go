@@ -0,0 +1,21 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+func TestIterators(t *testing.T) {
+	for _, n := range []int{0, 1, 5} {
+		s := New[int]()
+		indexes, backward := make([]int, n), make([]int, n)
+		for i := range n {
+			s = s.Append(10 * i)
+			indexes[i], backward[n-1-i] = i, 10*i
+		}
+		itertest.Seq(t, "All", s.All(), s)
+		itertest.Seq2(t, "Enumerate", s.Enumerate(), indexes, s)
+		itertest.Seq(t, "Backward", s.Backward(), backward)
+	}
+}
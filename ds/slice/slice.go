@@ -1,6 +1,14 @@
 package slice
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrIndexOutOfRange is returned when an index does not point into the Slice.
+// Like the other containers of this module, removing from an empty Slice reports false instead of an error.
+var ErrIndexOutOfRange = errors.New("slice: index out of range")
 
 type slice[T any] []T
 
@@ -19,9 +27,20 @@ func (s slice[T]) Prepend(v T) slice[T] {
 	return append([]T{v}, s...)
 }
 
-// Print - print the Slice
-func (s slice[T]) Print() {
-	fmt.Println(s)
+// String - return a string representation of the Slice
+func (s slice[T]) String() string {
+	return fmt.Sprint([]T(s))
+}
+
+// Format - implement fmt.Formatter: %v prints the values, %+v adds the length and capacity,
+// %#v prints them as a Go slice literal and any other verb is applied to each value
+func (s slice[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{len:%d cap:%d values:%+v}", len(s), cap(s), []T(s))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), []T(s))
+	}
 }
 
 // Len - get the length of the Slice
@@ -30,32 +49,48 @@ func (s slice[T]) Len() int {
 }
 
 // Get - get the value at the given index
-func (s slice[T]) Get(i int) T {
-	return s[i]
+func (s slice[T]) Get(i int) (T, error) {
+	if !s.inRange(i) {
+		var empty T
+		return empty, ErrIndexOutOfRange
+	}
+	return s[i], nil
 }
 
 // Set - set the value at the given index
-func (s slice[T]) Set(i int, v T) slice[T] {
+func (s slice[T]) Set(i int, v T) (slice[T], error) {
+	if !s.inRange(i) {
+		return s, ErrIndexOutOfRange
+	}
 	s[i] = v
-	return s
+	return s, nil
 }
 
 // Delete - delete the value at the given index
-func (s slice[T]) Delete(i int) slice[T] {
+func (s slice[T]) Delete(i int) (slice[T], error) {
+	if !s.inRange(i) {
+		return s, ErrIndexOutOfRange
+	}
 	s = append(s[:i], s[i+1:]...)
-	return s
+	return s, nil
 }
 
-// DeleteLast - delete the last value in the Slice
-func (s slice[T]) DeleteLast() slice[T] {
+// DeleteLast - delete the last value in the Slice, false if the Slice is empty
+func (s slice[T]) DeleteLast() (slice[T], bool) {
+	if len(s) == 0 {
+		return s, false
+	}
 	s = s[:len(s)-1]
-	return s
+	return s, true
 }
 
-// DeleteFirst - delete the first value in the Slice
-func (s slice[T]) DeleteFirst() slice[T] {
+// DeleteFirst - delete the first value in the Slice, false if the Slice is empty
+func (s slice[T]) DeleteFirst() (slice[T], bool) {
+	if len(s) == 0 {
+		return s, false
+	}
 	s = s[1:]
-	return s
+	return s, true
 }
 
 // DeleteAll - delete all values in the Slice
@@ -71,3 +106,41 @@ func (s slice[T]) Reverse() slice[T] {
 	}
 	return s
 }
+
+// All - iterate over the values of the Slice from first to last
+func (s slice[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate - iterate over the indexes and values of the Slice from first to last
+func (s slice[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - iterate over the values of the Slice from last to first
+func (s slice[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// inRange - check if the given index points into the Slice
+func (s slice[T]) inRange(i int) bool {
+	return i >= 0 && i < len(s)
+}
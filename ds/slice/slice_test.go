@@ -0,0 +1,85 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestIndexErrors(t *testing.T) {
+	s := New[int]().Append(1).Append(2)
+	for _, i := range []int{-1, 2, 10} {
+		if _, err := s.Get(i); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Get(%d) = %v, want ErrIndexOutOfRange", i, err)
+		}
+		if _, err := s.Set(i, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Set(%d) = %v, want ErrIndexOutOfRange", i, err)
+		}
+		if _, err := s.Delete(i); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Delete(%d) = %v, want ErrIndexOutOfRange", i, err)
+		}
+	}
+	if !slices.Equal(s, []int{1, 2}) {
+		t.Fatalf("failed calls changed the Slice to %v", s)
+	}
+	if _, err := New[int]().Get(0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Get(0) on an empty Slice = %v, want ErrIndexOutOfRange", err)
+	}
+
+	s, err := s.Set(1, 3)
+	if err != nil || !slices.Equal(s, []int{1, 3}) {
+		t.Fatalf("Set(1, 3) = %v, %v", s, err)
+	}
+	s, err = s.Delete(0)
+	if err != nil || !slices.Equal(s, []int{3}) {
+		t.Fatalf("Delete(0) = %v, %v", s, err)
+	}
+}
+
+func TestDeleteEnds(t *testing.T) {
+	for name, del := range map[string]func(slice[int]) (slice[int], bool){
+		"DeleteFirst": slice[int].DeleteFirst,
+		"DeleteLast":  slice[int].DeleteLast,
+	} {
+		if got, ok := del(New[int]()); ok || len(got) != 0 {
+			t.Errorf("%s() on an empty Slice = %v, %v, want false", name, got, ok)
+		}
+		if got, ok := del(nil); ok || got != nil {
+			t.Errorf("%s() on a nil Slice = %v, %v, want false", name, got, ok)
+		}
+	}
+	s := New[int]().Append(1).Append(2).Append(3)
+	s, ok := s.DeleteFirst()
+	if !ok || !slices.Equal(s, []int{2, 3}) {
+		t.Fatalf("DeleteFirst() = %v, %v", s, ok)
+	}
+	s, ok = s.DeleteLast()
+	if !ok || !slices.Equal(s, []int{2}) {
+		t.Fatalf("DeleteLast() = %v, %v", s, ok)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	s := New[int]().Append(2).Append(3).Prepend(1)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "[1 2 3]"},
+		{"%+v", fmt.Sprintf("{len:3 cap:%d values:[1 2 3]}", cap(s))},
+		{"%#v", "[]int{1, 2, 3}"},
+		{"%02d", "[01 02 03]"},
+	}
+	if got := s.String(); got != "[1 2 3]" {
+		t.Errorf("String() = %q, want %q", got, "[1 2 3]")
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, s); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+	if got := fmt.Sprintf("%#v", New[string]()); got != "[]string{}" {
+		t.Errorf("Sprintf(%%#v) of an empty Slice = %q, want %q", got, "[]string{}")
+	}
+}
@@ -0,0 +1,94 @@
+package stack
+
+import "sync"
+
+// Concurrent is a Stack that is safe for concurrent use by multiple goroutines.
+// Compound operations such as PopN are atomic: no other goroutine can interleave with them.
+type Concurrent[T any] struct {
+	mu sync.RWMutex
+	s  Stack[T]
+}
+
+// NewConcurrent: Creates a new concurrent stack that grows dynamically.
+func NewConcurrent[T any]() *Concurrent[T] {
+	return &Concurrent[T]{}
+}
+
+// NewConcurrentBounded: Creates a new concurrent stack that holds at most capacity items.
+func NewConcurrentBounded[T any](capacity int) *Concurrent[T] {
+	return &Concurrent[T]{s: *NewBounded[T](capacity)}
+}
+
+// Push: Adds element to the top of the stack and return ErrFull if the stack is full.
+func (c *Concurrent[T]) Push(item T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.s.Push(item)
+}
+
+// PushIfNotFull: Adds element to the top of the stack only if the stack is not full.
+// It reports whether the element was added.
+func (c *Concurrent[T]) PushIfNotFull(item T) bool {
+	return c.Push(item) == nil
+}
+
+// Pop: Removes the top most element from the stack and returns it, false if the stack is empty.
+func (c *Concurrent[T]) Pop() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.s.Pop()
+}
+
+// PopN: Removes up to n elements from the top of the stack, returning them top first.
+// It returns an empty slice if the stack is empty or n <= 0.
+func (c *Concurrent[T]) PopN(n int) []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if n > c.s.Len() {
+		n = c.s.Len()
+	}
+	if n <= 0 {
+		return []T{}
+	}
+	items := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		item, _ := c.s.Pop()
+		items = append(items, item)
+	}
+	return items
+}
+
+// Peek: Return the top most element from the stack, false if the stack is empty.
+func (c *Concurrent[T]) Peek() (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Peek()
+}
+
+// Len: Return the number of elements in the stack.
+func (c *Concurrent[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Len()
+}
+
+// IsEmpty: Check if the stack is empty.
+func (c *Concurrent[T]) IsEmpty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.IsEmpty()
+}
+
+// IsFull: Check if the stack is full.
+func (c *Concurrent[T]) IsFull() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.IsFull()
+}
+
+// Clear: Removes all elements from the stack.
+func (c *Concurrent[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s.Clear()
+}
@@ -0,0 +1,96 @@
+package stack
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	goroutines = 8
+	perWorker  = 1000
+)
+
+func TestConcurrentPushPop(t *testing.T) {
+	c := NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				if err := c.Push(g*perWorker + i); err != nil {
+					t.Errorf("Push() = %v on an unbounded stack", err)
+					return
+				}
+				c.Peek()
+				c.Len()
+			}
+		}()
+	}
+	wg.Wait()
+
+	results := make(chan []int, goroutines)
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var got []int
+			for !c.IsEmpty() {
+				if g%2 == 0 {
+					got = append(got, c.PopN(5)...)
+				} else if v, ok := c.Pop(); ok {
+					got = append(got, v)
+				}
+			}
+			results <- got
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[int]bool)
+	for got := range results {
+		for _, v := range got {
+			if seen[v] {
+				t.Fatalf("value %d popped twice", v)
+			}
+			seen[v] = true
+		}
+	}
+	if len(seen) != goroutines*perWorker {
+		t.Fatalf("popped %d values, want %d", len(seen), goroutines*perWorker)
+	}
+}
+
+func TestConcurrentBounded(t *testing.T) {
+	const capacity = 100
+	c := NewConcurrentBounded[int](capacity)
+	var pushed, full atomic.Int64
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				switch err := c.Push(i); {
+				case err == nil:
+					pushed.Add(1)
+				case errors.Is(err, ErrFull):
+					full.Add(1)
+				default:
+					t.Errorf("Push() = %v", err)
+				}
+				if g%2 == 0 && c.PushIfNotFull(i) {
+					pushed.Add(1)
+				}
+				c.IsFull()
+			}
+		}()
+	}
+	wg.Wait()
+	if pushed.Load() != capacity || c.Len() != capacity {
+		t.Fatalf("%d pushes succeeded and Len() = %d, want %d", pushed.Load(), c.Len(), capacity)
+	}
+}
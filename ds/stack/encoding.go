@@ -0,0 +1,58 @@
+package stack
+
+import (
+	"encoding/json"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/gobslice"
+)
+
+// A Stack encodes as its items from bottom to top: a JSON array for encoding/json and a gob encoded
+// slice for encoding.BinaryMarshaler, which encoding/gob also uses to encode it.
+// The bound of a bounded stack is not encoded; decoding into a bounded stack fails with ErrFull
+// if the items do not fit.
+
+// MarshalJSON: Encode the stack as a JSON array, bottom first.
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.values())
+}
+
+// UnmarshalJSON: Replace the items of the stack with a JSON array, bottom first.
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+// MarshalBinary: Encode the stack with encoding/gob, bottom first.
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return gobslice.Marshal(s.values())
+}
+
+// UnmarshalBinary: Replace the items of the stack with items encoded by MarshalBinary.
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	items, err := gobslice.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+// values returns the items of the stack, bottom first, never nil.
+func (s *Stack[T]) values() []T {
+	if s.items == nil {
+		return []T{}
+	}
+	return s.items
+}
+
+// load replaces the items of the stack.
+func (s *Stack[T]) load(items []T) error {
+	if s.limit > 0 && len(items) > s.limit {
+		return ErrFull
+	}
+	s.Clear()
+	s.items = append(s.items, items...)
+	return nil
+}
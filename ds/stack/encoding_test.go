@@ -0,0 +1,38 @@
+package stack
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/codectest"
+)
+
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte{}, 0)
+	f.Add([]byte{0}, 1)
+	f.Add([]byte("a stack"), 3)
+	f.Fuzz(func(t *testing.T, data []byte, limit int) {
+		values := codectest.Values(data)
+		limit = limit%16 + 1
+		if limit < 1 {
+			limit += 16
+		}
+		s := New[int]()
+		for _, v := range values {
+			s.Push(v)
+		}
+
+		codectest.RoundTrip(t, "Stack", s, New[int], (*Stack[int]).values, values)
+		for name, codec := range codectest.Codecs {
+			bounded := NewBounded[int](limit)
+			err := codec(s, bounded)
+			switch {
+			case len(values) > limit && !errors.Is(err, ErrFull):
+				t.Fatalf("%s into a stack bounded to %d: %v, want ErrFull", name, limit, err)
+			case len(values) <= limit && (err != nil || !slices.Equal(bounded.values(), values)):
+				t.Fatalf("%s into a stack bounded to %d = %v, %v, want %v", name, limit, bounded.values(), err, values)
+			}
+		}
+	})
+}
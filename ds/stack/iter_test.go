@@ -0,0 +1,20 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+func TestIterators(t *testing.T) {
+	for _, n := range []int{0, 1, 5} {
+		s := New[int]()
+		pushed, popped := make([]int, n), make([]int, n)
+		for i := range n {
+			s.Push(i)
+			pushed[i], popped[n-1-i] = i, i
+		}
+		itertest.Seq(t, "All", s.All(), popped)
+		itertest.Seq(t, "Backward", s.Backward(), pushed)
+	}
+}
@@ -1,6 +1,14 @@
 package stack
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrFull is returned by Push when the stack has no room left.
+// Popping from an empty stack reports false instead of an error, like the other containers of this module.
+var ErrFull = errors.New("stack: full")
 
 // Stack is a linear data structure which follows a particular order in which the operations are performed. The order may be LIFO(Last In First Out) or FILO(First In Last Out). The order is determined by the implementation of the data structure.
 
@@ -11,12 +19,12 @@ import "fmt"
 // Basic operation of Stack
 
 // These are the operations we are going to implement
-// Push: Adds element to the top of the stack and return “Stack Overflow” if the stack is full.
-// Pop: Removes the top most element from the stack and return “Stack Underflow” if the stack is empty.
+// Push: Adds element to the top of the stack and return ErrFull (“Stack Overflow”) if the stack is full.
+// Pop: Removes the top most element from the stack and return false (“Stack Underflow”) if the stack is empty.
 // isEmpty: Check if the stack is empty.
 // isFull: Check if the stack is full
 // Peek: Return the top most element from the stack.
-// String: Display the items of stack
+// String: Return the items of stack
 
 // Basic usage of stack:
 // Converting infix to postfix expressions.
@@ -40,7 +48,8 @@ import "fmt"
 // Implementing a stack using Array/Slice
 
 // Pros: Easy to implement. Memory is saved as pointers are not involved.
-// Cons: It is not dynamic. It doesn’t grow and shrink depending on needs at runtime.
+// Cons: A fixed size array is not dynamic. It doesn’t grow and shrink depending on needs at runtime.
+// Stack below is backed by a Go slice, so by default it grows as needed. NewBounded gives the classic fixed size behaviour.
 
 // Pros and Cons of Stack Data Structure
 // Advantages of Stack:
@@ -56,66 +65,121 @@ import "fmt"
 // - Random accessing is not possible in stack.
 // - The total of size of the stack must be defined before.
 // - If the stack falls outside the memory it can lead to abnormal termination.
-type stack[T any] struct {
-	arr []any
-	top int
+type Stack[T any] struct {
+	items []T
+	// limit is the maximum number of items, 0 for a stack that grows dynamically.
+	limit int
+}
+
+// New: Creates a new stack that grows dynamically.
+func New[T any]() *Stack[T] {
+	return &Stack[T]{}
 }
 
-// Push: Adds element to the top of the stack and return “Stack Overflow” if the stack is full.
-func (s *stack[T]) Push(item any) {
-	if s.top == len(s.arr) {
-		fmt.Println("Stack Overflow")
-		return
+// NewBounded: Creates a new stack that holds at most capacity items.
+// Push returns ErrFull once the stack is full. A capacity <= 0 means unbounded.
+func NewBounded[T any](capacity int) *Stack[T] {
+	if capacity <= 0 {
+		return New[T]()
 	}
-	s.arr[s.top] = item
-	s.top++
+	return &Stack[T]{items: make([]T, 0, capacity), limit: capacity}
 }
 
-// Pop: Removes the top most element from the stack and return “Stack Underflow” if the stack is empty.
-func New[T any]() *stack[T] {
-	var s stack[T]
-	s.arr = make([]any, 10)
-	s.top = 0
-	return &s
+// Push: Adds element to the top of the stack and return ErrFull (“Stack Overflow”) if the stack is full.
+func (s *Stack[T]) Push(item T) error {
+	if s.IsFull() {
+		return ErrFull
+	}
+	s.items = append(s.items, item)
+	return nil
 }
 
-func (s *stack[T]) Pop() {
-	if s.top == 0 {
-		fmt.Println("Stack Underflow")
-		return
+// Pop: Removes the top most element from the stack and returns it, false (“Stack Underflow”) if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var empty T
+	if len(s.items) == 0 {
+		return empty, false
 	}
-	s.top--
-	s.arr[s.top] = nil
+	top := len(s.items) - 1
+	item := s.items[top]
+	s.items[top] = empty
+	s.items = s.items[:top]
+	return item, true
 }
 
-// isEmpty: Check if the stack is empty.
-func (s *stack[T]) isEmpty() bool {
-	return s.top == 0
+// Peek: Return the top most element from the stack, false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var empty T
+		return empty, false
+	}
+	return s.items[len(s.items)-1], true
 }
 
-// isFull: Check if the stack is full
-func (s *stack[T]) isFull() bool {
-	return s.top == len(s.arr)
+// Len: Return the number of elements in the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
 }
 
-// Peek: Return the top most element from the stack.
-func (s *stack[T]) Peek() any {
-	if s.top == 0 {
-		fmt.Println("Stack Underflow")
-		return nil
+// IsEmpty: Check if the stack is empty.
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// IsFull: Check if the stack is full, always false for a stack that grows dynamically.
+func (s *Stack[T]) IsFull() bool {
+	return s.limit > 0 && len(s.items) >= s.limit
+}
+
+// Clear: Removes all elements from the stack.
+func (s *Stack[T]) Clear() {
+	var empty T
+	for i := range s.items {
+		s.items[i] = empty
+	}
+	s.items = s.items[:0]
+}
+
+// All: Return an iterator over the elements from top to bottom, the order Pop would return them in.
+// The stack must not be modified during the iteration.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
 	}
-	return s.arr[s.top-1]
 }
 
-// String: Display the items of stack
-func (s *stack[T]) String() {
-	if s.isEmpty() {
-		fmt.Println("Stack is empty")
-		return
+// Backward: Return an iterator over the elements from bottom to top, the order they were pushed in.
+// The stack must not be modified during the iteration.
+func (s *Stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
 	}
-	var str string
-	for i := 0; i < s.top; i++ {
-		str += fmt.Sprintf("%v ", s.arr[i])
+}
+
+// String: Return the items of stack from bottom to top.
+func (s *Stack[T]) String() string {
+	return fmt.Sprint(s.items)
+}
+
+// Format: Implement fmt.Formatter. %v prints the items from bottom to top, %+v adds the length and
+// capacity (the bound of a bounded stack), %#v prints them as a Go slice literal and any other verb is applied to each item.
+func (s *Stack[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		capacity := s.limit
+		if capacity == 0 {
+			capacity = cap(s.items)
+		}
+		fmt.Fprintf(f, "{len:%d cap:%d items:%+v}", len(s.items), capacity, s.items)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), s.items)
 	}
-	fmt.Println(str)
 }
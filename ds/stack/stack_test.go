@@ -0,0 +1,128 @@
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestEmpty(t *testing.T) {
+	for name, s := range map[string]*Stack[int]{"new": New[int](), "bounded": NewBounded[int](2), "zero": {}} {
+		if _, ok := s.Pop(); ok {
+			t.Errorf("%s: Pop() on an empty stack = true", name)
+		}
+		if _, ok := s.Peek(); ok {
+			t.Errorf("%s: Peek() on an empty stack = true", name)
+		}
+		s.Push(1)
+		s.Pop()
+		if _, ok := s.Pop(); ok || !s.IsEmpty() {
+			t.Errorf("%s: Pop() on a drained stack = true", name)
+		}
+	}
+}
+
+func TestBoundedFull(t *testing.T) {
+	s := NewBounded[int](2)
+	for i := range 2 {
+		if err := s.Push(i); err != nil {
+			t.Fatalf("Push(%d) = %v", i, err)
+		}
+	}
+	if err := s.Push(2); !errors.Is(err, ErrFull) {
+		t.Fatalf("Push() on a full stack = %v, want ErrFull", err)
+	}
+	if v, _ := s.Peek(); v != 1 || s.Len() != 2 {
+		t.Fatalf("a rejected Push changed the stack: top %d, Len() %d", v, s.Len())
+	}
+	s.Pop()
+	if err := s.Push(3); err != nil {
+		t.Fatalf("Push() after Pop = %v, want room for one item", err)
+	}
+	for _, capacity := range []int{0, -1} {
+		s := NewBounded[int](capacity)
+		for i := range 100 {
+			if err := s.Push(i); err != nil {
+				t.Fatalf("NewBounded(%d): Push() = %v, want an unbounded stack", capacity, err)
+			}
+		}
+	}
+}
+
+func TestLIFO(t *testing.T) {
+	s := New[int]()
+	for i := range 100 {
+		s.Push(i)
+		if v, ok := s.Peek(); !ok || v != i {
+			t.Fatalf("Peek() = %d, %v after Push(%d)", v, ok, i)
+		}
+	}
+	if s.Len() != 100 || s.IsFull() {
+		t.Fatalf("Len() = %d, IsFull() = %v, want 100, false", s.Len(), s.IsFull())
+	}
+	for want := 99; want >= 50; want-- {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Fatalf("Pop() = %d, %v, want %d", v, ok, want)
+		}
+	}
+	// Pushes after Pops go on top of what is left.
+	s.Push(100)
+	for _, want := range []int{100, 49, 48} {
+		if v, _ := s.Pop(); v != want {
+			t.Fatalf("Pop() = %d, want %d", v, want)
+		}
+	}
+}
+
+func TestClear(t *testing.T) {
+	s := NewBounded[*int](3)
+	for i := range 3 {
+		s.Push(&i)
+	}
+	items := s.items
+	s.Clear()
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Fatalf("Len() = %d after Clear", s.Len())
+	}
+	for i, p := range items[:cap(items)] {
+		if p != nil {
+			t.Fatalf("slot %d still holds an item after Clear", i)
+		}
+	}
+	// Clear keeps the bound.
+	for i := range 3 {
+		if err := s.Push(&i); err != nil {
+			t.Fatalf("Push() after Clear = %v", err)
+		}
+	}
+	if err := s.Push(nil); !errors.Is(err, ErrFull) {
+		t.Fatalf("Push() on a full stack after Clear = %v, want ErrFull", err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	s := NewBounded[int](4)
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "[1 2 3]"},
+		{"%+v", "{len:3 cap:4 items:[1 2 3]}"},
+		{"%#v", "[]int{1, 2, 3}"},
+		{"%02d", "[01 02 03]"},
+	}
+	if got := s.String(); got != "[1 2 3]" {
+		t.Errorf("String() = %q, want %q", got, "[1 2 3]")
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, s); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+	if got := fmt.Sprintf("%#v", New[string]()); got != "[]string(nil)" {
+		t.Errorf("Sprintf(%%#v) of an empty stack = %q, want %q", got, "[]string(nil)")
+	}
+}
@@ -0,0 +1,248 @@
+package bst
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// # Binary Search Tree - Data Structure
+
+// A Binary Search Tree is a tree where every node has at most two children and keeps the BST property: the keys of its left subtree are all smaller than its key and the keys of its right subtree are all larger. So an in-order walk (left, node, right) visits the keys in ascending order, and a search only follows one path from the root.
+
+// ## Operations:
+// - Search/Insert: start at the root and go left or right depending on the key, until the key or an empty child is found.
+// - Delete: a node with at most one child is replaced by that child. A node with two children takes the key of its successor, the smallest key of its right subtree, and the successor node is deleted instead.
+// - Min/Max: the leftmost and rightmost nodes.
+// - Successor/Predecessor: the next larger or smaller key, found on the way down from the root.
+
+// All of them are O(h), h being the height of the tree: O(log n) when the tree is balanced, but O(n) when keys are inserted in sorted order and the tree degenerates into a linked list. The self-balancing trees of the treemap package keep h in O(log n).
+
+// ## Traversals:
+// - In-order (left, node, right): the keys in ascending order.
+// - Pre-order (node, left, right): parents before children, the order to copy or serialize a tree.
+// - Post-order (left, right, node): children before parents, the order to free or evaluate a tree.
+// - Level-order: level by level from the root, breadth first.
+// The depth first traversals keep the path from the root on a stack and level-order keeps the next level on a queue, so no traversal recurses.
+
+// ## Usages:
+// - Ordered maps and sets, symbol tables, indexes that need range queries and sorted iteration.
+// - The basis of the balanced trees (AVL, red-black, B-trees) used by databases and language runtimes.
+
+// ErrInvalidTree is wrapped by the errors Validate returns.
+var ErrInvalidTree = errors.New("bst: invalid tree")
+
+// Tree is a binary search tree mapping keys of type K to values of type V.
+type Tree[K, V any] struct {
+	root *node[K, V]
+	cmp  func(a, b K) int
+	size int
+}
+
+// node is a node of a Tree.
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+}
+
+// New returns an empty Tree ordered by the natural order of K.
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc returns an empty Tree ordered by cmp, which returns a negative number when a < b,
+// a positive number when a > b and zero when a and b are the same key.
+func NewFunc[K, V any](cmp func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{cmp: cmp}
+}
+
+// Insert - Adds a key with its value, replacing the value if the key is already in the Tree.
+func (t *Tree[K, V]) Insert(key K, value V) {
+	link := &t.root
+	for *link != nil {
+		switch c := t.cmp(key, (*link).key); {
+		case c < 0:
+			link = &(*link).left
+		case c > 0:
+			link = &(*link).right
+		default:
+			(*link).value = value
+			return
+		}
+	}
+	*link = &node[K, V]{key: key, value: value}
+	t.size++
+}
+
+// Delete - Removes a key and its value, false if the key is not in the Tree.
+func (t *Tree[K, V]) Delete(key K) bool {
+	link := t.find(key)
+	if *link == nil {
+		return false
+	}
+	n := *link
+	switch {
+	case n.left == nil:
+		*link = n.right
+	case n.right == nil:
+		*link = n.left
+	default:
+		// Unlink the successor, the leftmost node of the right subtree, and move it into place.
+		succ := &n.right
+		for (*succ).left != nil {
+			succ = &(*succ).left
+		}
+		s := *succ
+		*succ = s.right
+		s.left, s.right = n.left, n.right
+		*link = s
+	}
+	t.size--
+	return true
+}
+
+// Search - Returns the value of a key, false if the key is not in the Tree.
+func (t *Tree[K, V]) Search(key K) (V, bool) {
+	if n := *t.find(key); n != nil {
+		return n.value, true
+	}
+	var empty V
+	return empty, false
+}
+
+// Contains - Checks if a key is in the Tree.
+func (t *Tree[K, V]) Contains(key K) bool {
+	return *t.find(key) != nil
+}
+
+// Min - Returns the smallest key and its value, false if the Tree is empty.
+func (t *Tree[K, V]) Min() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return entry[K, V](nil)
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return entry(n)
+}
+
+// Max - Returns the largest key and its value, false if the Tree is empty.
+func (t *Tree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return entry[K, V](nil)
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return entry(n)
+}
+
+// Successor - Returns the smallest key larger than key and its value, false if there is none.
+// key does not have to be in the Tree.
+func (t *Tree[K, V]) Successor(key K) (K, V, bool) {
+	var succ *node[K, V]
+	for n := t.root; n != nil; {
+		if t.cmp(key, n.key) < 0 {
+			succ, n = n, n.left
+		} else {
+			n = n.right
+		}
+	}
+	return entry(succ)
+}
+
+// Predecessor - Returns the largest key smaller than key and its value, false if there is none.
+// key does not have to be in the Tree.
+func (t *Tree[K, V]) Predecessor(key K) (K, V, bool) {
+	var pred *node[K, V]
+	for n := t.root; n != nil; {
+		if t.cmp(key, n.key) > 0 {
+			pred, n = n, n.right
+		} else {
+			n = n.left
+		}
+	}
+	return entry(pred)
+}
+
+// Len - Returns the number of keys in the Tree.
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty - Checks if the Tree is empty.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear - Removes all keys from the Tree.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Height - Returns the number of nodes on the longest path from the root to a leaf, 0 if the Tree is empty.
+func (t *Tree[K, V]) Height() int {
+	height := 0
+	for _, level := range t.levels() {
+		height = level + 1
+	}
+	return height
+}
+
+// Validate - Checks that the keys follow the BST property and that the Tree holds Len keys.
+// It returns an error wrapping ErrInvalidTree describing the first problem found.
+func (t *Tree[K, V]) Validate() error {
+	count := 0
+	var prev *node[K, V]
+	for n := range t.inOrder() {
+		if prev != nil && t.cmp(prev.key, n.key) >= 0 {
+			return fmt.Errorf("%w: key %v is not smaller than the next key %v", ErrInvalidTree, prev.key, n.key)
+		}
+		prev = n
+		count++
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: holds %d keys but Len is %d", ErrInvalidTree, count, t.size)
+	}
+	return nil
+}
+
+// String - Returns a string representation of the Tree, in ascending order of keys.
+func (t *Tree[K, V]) String() string {
+	items := make([]string, 0, t.size)
+	for n := range t.inOrder() {
+		items = append(items, fmt.Sprintf("%v:%v", n.key, n.value))
+	}
+	return "map[" + strings.Join(items, " ") + "]"
+}
+
+// find returns the link that points to the node of key, which points to nil if key is not in the Tree.
+func (t *Tree[K, V]) find(key K) **node[K, V] {
+	link := &t.root
+	for *link != nil {
+		switch c := t.cmp(key, (*link).key); {
+		case c < 0:
+			link = &(*link).left
+		case c > 0:
+			link = &(*link).right
+		default:
+			return link
+		}
+	}
+	return link
+}
+
+// entry returns the key and value of n, false if n is nil.
+func entry[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
@@ -0,0 +1,223 @@
+package bst
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// known is the insertion order of the tree most tests start from:
+//
+//	         50
+//	     /        \
+//	   30          70
+//	  /  \        /  \
+//	20    40    60    80
+//	     /  \     \
+//	   35    45    65
+var known = []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 65}
+
+// build returns a Tree of keys inserted in order, each with its decimal string as value.
+func build(keys ...int) *Tree[int, string] {
+	t := New[int, string]()
+	for _, k := range keys {
+		t.Insert(k, strconv.Itoa(k))
+	}
+	return t
+}
+
+// preOrder returns the keys of t in pre-order, which with in-order fixes the shape of the tree.
+func preOrder(t *Tree[int, string]) []int {
+	var keys []int
+	for k := range t.PreOrder() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// mustValidate fails the test if t is not a valid Tree.
+func mustValidate(t *testing.T, tree *Tree[int, string]) {
+	t.Helper()
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tree := build(known...)
+	mustValidate(t, tree)
+	if got := preOrder(tree); !slices.Equal(got, []int{50, 30, 20, 40, 35, 45, 70, 60, 65, 80}) {
+		t.Fatalf("PreOrder() = %v after inserting %v", got, known)
+	}
+	// Inserting a key again replaces its value and keeps the shape.
+	tree.Insert(40, "forty")
+	if v, ok := tree.Search(40); !ok || v != "forty" || tree.Len() != len(known) {
+		t.Fatalf("Search(40) = %q, %v and Len() = %d after replacing its value", v, ok, tree.Len())
+	}
+	if got := preOrder(tree); !slices.Equal(got, []int{50, 30, 20, 40, 35, 45, 70, 60, 65, 80}) {
+		t.Fatalf("PreOrder() = %v after replacing a value", got)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      int
+		preOrder []int
+	}{
+		{"leaf", 20, []int{50, 30, 40, 35, 45, 70, 60, 65, 80}},
+		{"leaf of a node with two children", 45, []int{50, 30, 20, 40, 35, 70, 60, 65, 80}},
+		{"only a right child", 60, []int{50, 30, 20, 40, 35, 45, 70, 65, 80}},
+		// 35 is the successor of 30, the leftmost node of its right subtree.
+		{"two children", 30, []int{50, 35, 20, 40, 45, 70, 60, 65, 80}},
+		// 80 is the successor of 70 and its right child.
+		{"two children, successor is the right child", 70, []int{50, 30, 20, 40, 35, 45, 80, 60, 65}},
+		// 60 is the successor of the root, and its right child 65 takes its place.
+		{"root", 50, []int{60, 30, 20, 40, 35, 45, 70, 65, 80}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := build(known...)
+			if !tree.Delete(tt.key) {
+				t.Fatalf("Delete(%d) = false", tt.key)
+			}
+			mustValidate(t, tree)
+			if got := preOrder(tree); !slices.Equal(got, tt.preOrder) {
+				t.Fatalf("PreOrder() = %v after Delete(%d), want %v", got, tt.key, tt.preOrder)
+			}
+			if tree.Contains(tt.key) || tree.Len() != len(known)-1 {
+				t.Fatalf("Contains(%d) = %v and Len() = %d after deleting it", tt.key, tree.Contains(tt.key), tree.Len())
+			}
+			// Every other key keeps its value.
+			for _, k := range known {
+				if v, ok := tree.Search(k); k != tt.key && (!ok || v != strconv.Itoa(k)) {
+					t.Fatalf("Search(%d) = %q, %v after Delete(%d)", k, v, ok, tt.key)
+				}
+			}
+			if tree.Delete(tt.key) {
+				t.Fatalf("Delete(%d) = true a second time", tt.key)
+			}
+		})
+	}
+
+	// A node with only a left child, and the last nodes of a Tree.
+	tree := build(2, 1)
+	if !tree.Delete(2) || !slices.Equal(preOrder(tree), []int{1}) {
+		t.Fatalf("PreOrder() = %v after deleting a root with only a left child", preOrder(tree))
+	}
+	if !tree.Delete(1) || !tree.IsEmpty() || tree.Height() != 0 {
+		t.Fatalf("deleting the last key left %v", tree)
+	}
+	mustValidate(t, tree)
+	if tree.Delete(1) {
+		t.Fatal("Delete() = true in an empty Tree")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tree := build(known...)
+	tree.root.left.right.key = 55
+	if err := tree.Validate(); !errors.Is(err, ErrInvalidTree) {
+		t.Fatalf("Validate() of a tree with 55 left of 50 = %v, want ErrInvalidTree", err)
+	}
+	tree = build(known...)
+	tree.size++
+	if err := tree.Validate(); !errors.Is(err, ErrInvalidTree) {
+		t.Fatalf("Validate() with a wrong Len = %v, want ErrInvalidTree", err)
+	}
+}
+
+func TestRandomized(t *testing.T) {
+	for seed := range uint64(20) {
+		r := rand.New(rand.NewPCG(seed, seed))
+		tree := New[int, string]()
+		ref := map[int]string{}
+		for step := range 2000 {
+			k := r.IntN(200)
+			if r.IntN(3) == 0 {
+				_, ok := ref[k]
+				if got := tree.Delete(k); got != ok {
+					t.Fatalf("seed %d step %d: Delete(%d) = %v, want %v", seed, step, k, got, ok)
+				}
+				delete(ref, k)
+			} else {
+				v := strconv.Itoa(step)
+				tree.Insert(k, v)
+				ref[k] = v
+			}
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("seed %d step %d: %v", seed, step, err)
+			}
+			if tree.Len() != len(ref) {
+				t.Fatalf("seed %d step %d: Len() = %d, want %d", seed, step, tree.Len(), len(ref))
+			}
+		}
+
+		keys := slices.Sorted(maps.Keys(ref))
+		var got []int
+		for k, v := range tree.All() {
+			if v != ref[k] {
+				t.Fatalf("seed %d: All() yields %d: %q, want %q", seed, k, v, ref[k])
+			}
+			got = append(got, k)
+		}
+		if !slices.Equal(got, keys) {
+			t.Fatalf("seed %d: All() = %v, want %v", seed, got, keys)
+		}
+		checkOrderQueries(t, tree, ref, keys)
+	}
+}
+
+// checkOrderQueries checks Min, Max, Successor and Predecessor of tree against the map ref and its sorted keys.
+func checkOrderQueries(t *testing.T, tree *Tree[int, string], ref map[int]string, keys []int) {
+	t.Helper()
+	if k, _, ok := tree.Min(); ok != (len(keys) > 0) || ok && k != keys[0] {
+		t.Fatalf("Min() = %d, %v, want the first of %v", k, ok, keys)
+	}
+	if k, _, ok := tree.Max(); ok != (len(keys) > 0) || ok && k != keys[len(keys)-1] {
+		t.Fatalf("Max() = %d, %v, want the last of %v", k, ok, keys)
+	}
+	for key := -1; key <= 201; key++ {
+		i, found := slices.BinarySearch(keys, key)
+		if found {
+			i++
+		}
+		k, v, ok := tree.Successor(key)
+		if ok != (i < len(keys)) || ok && (k != keys[i] || v != ref[k]) {
+			t.Fatalf("Successor(%d) = %d, %v", key, k, ok)
+		}
+		j, _ := slices.BinarySearch(keys, key)
+		k, _, ok = tree.Predecessor(key)
+		if ok != (j > 0) || ok && k != keys[j-1] {
+			t.Fatalf("Predecessor(%d) = %d, %v", key, k, ok)
+		}
+	}
+}
+
+func TestSortedInsertion(t *testing.T) {
+	// Keys inserted in order make a list: every node is the right child of the previous one.
+	tree := build(1, 2, 3, 4, 5, 6, 7, 8)
+	if tree.Height() != 8 {
+		t.Fatalf("Height() = %d, want 8", tree.Height())
+	}
+	for tree.Len() > 0 {
+		k, _, _ := tree.Min()
+		tree.Delete(k)
+		mustValidate(t, tree)
+	}
+	if tree := build(known...); tree.Height() != 4 {
+		t.Fatalf("Height() = %d, want 4", tree.Height())
+	}
+}
+
+func TestString(t *testing.T) {
+	if got := build(2, 1, 3).String(); got != "map[1:1 2:2 3:3]" {
+		t.Fatalf("String() = %q", got)
+	}
+	if got := New[int, string]().String(); got != "map[]" {
+		t.Fatalf("String() of an empty Tree = %q", got)
+	}
+}
@@ -0,0 +1,138 @@
+package bst
+
+import (
+	"iter"
+
+	"github.com/rama-kairi/ds-algo/ds/queue"
+	"github.com/rama-kairi/ds-algo/ds/stack"
+)
+
+// The traversals walk the Tree without recursion: the depth first ones keep the nodes still to visit on
+// an unbounded stack.Stack, whose Push never fails, and LevelOrder keeps them on a queue.Queue.
+
+// All - Returns an iterator over the keys and values in ascending order of keys. It is InOrder.
+func (t *Tree[K, V]) All() iter.Seq2[K, V] {
+	return t.InOrder()
+}
+
+// InOrder - Returns an iterator over the keys and values in ascending order of keys.
+func (t *Tree[K, V]) InOrder() iter.Seq2[K, V] {
+	return pairs(t.inOrder())
+}
+
+// PreOrder - Returns an iterator over the keys and values, every node before its left and right subtrees.
+func (t *Tree[K, V]) PreOrder() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root == nil {
+			return
+		}
+		s := stack.New[*node[K, V]]()
+		s.Push(t.root)
+		for n, ok := s.Pop(); ok; n, ok = s.Pop() {
+			if !yield(n.key, n.value) {
+				return
+			}
+			// The right child is pushed first so that the left subtree is visited first.
+			if n.right != nil {
+				s.Push(n.right)
+			}
+			if n.left != nil {
+				s.Push(n.left)
+			}
+		}
+	}
+}
+
+// PostOrder - Returns an iterator over the keys and values, every node after its left and right subtrees.
+func (t *Tree[K, V]) PostOrder() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s := stack.New[*node[K, V]]()
+		var last *node[K, V]
+		for n := t.root; n != nil || !s.IsEmpty(); {
+			if n != nil {
+				s.Push(n)
+				n = n.left
+				continue
+			}
+			top, _ := s.Peek()
+			// Go down the right subtree first, unless it was just visited.
+			if top.right != nil && top.right != last {
+				n = top.right
+				continue
+			}
+			s.Pop()
+			if !yield(top.key, top.value) {
+				return
+			}
+			last = top
+		}
+	}
+}
+
+// LevelOrder - Returns an iterator over the keys and values level by level from the root,
+// each level from left to right.
+func (t *Tree[K, V]) LevelOrder() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range t.levels() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// inOrder returns an iterator over the nodes in ascending order of keys.
+func (t *Tree[K, V]) inOrder() iter.Seq[*node[K, V]] {
+	return func(yield func(*node[K, V]) bool) {
+		s := stack.New[*node[K, V]]()
+		for n := t.root; n != nil || !s.IsEmpty(); {
+			if n != nil {
+				s.Push(n)
+				n = n.left
+				continue
+			}
+			n, _ = s.Pop()
+			if !yield(n) {
+				return
+			}
+			n = n.right
+		}
+	}
+}
+
+// levels returns an iterator over the nodes in level order, with the level of each node, 0 for the root.
+func (t *Tree[K, V]) levels() iter.Seq2[*node[K, V], int] {
+	type leveled struct {
+		node  *node[K, V]
+		level int
+	}
+	return func(yield func(*node[K, V], int) bool) {
+		if t.root == nil {
+			return
+		}
+		q := queue.NewQueue[leveled]()
+		q.Enqueue(leveled{t.root, 0})
+		for item, ok := q.Dequeue(); ok; item, ok = q.Dequeue() {
+			if !yield(item.node, item.level) {
+				return
+			}
+			if item.node.left != nil {
+				q.Enqueue(leveled{item.node.left, item.level + 1})
+			}
+			if item.node.right != nil {
+				q.Enqueue(leveled{item.node.right, item.level + 1})
+			}
+		}
+	}
+}
+
+// pairs turns an iterator over nodes into an iterator over their keys and values.
+func pairs[K, V any](nodes iter.Seq[*node[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range nodes {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
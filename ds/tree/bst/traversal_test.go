@@ -0,0 +1,35 @@
+package bst
+
+import (
+	"iter"
+	"strconv"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/itertest"
+)
+
+func TestTraversals(t *testing.T) {
+	tree := build(known...)
+	tests := []struct {
+		name string
+		seq  func(*Tree[int, string]) iter.Seq2[int, string]
+		keys []int
+	}{
+		{"All", (*Tree[int, string]).All, []int{20, 30, 35, 40, 45, 50, 60, 65, 70, 80}},
+		{"InOrder", (*Tree[int, string]).InOrder, []int{20, 30, 35, 40, 45, 50, 60, 65, 70, 80}},
+		{"PreOrder", (*Tree[int, string]).PreOrder, []int{50, 30, 20, 40, 35, 45, 70, 60, 65, 80}},
+		{"PostOrder", (*Tree[int, string]).PostOrder, []int{20, 35, 45, 40, 30, 65, 60, 80, 70, 50}},
+		{"LevelOrder", (*Tree[int, string]).LevelOrder, []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 65}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]string, len(tt.keys))
+			for i, k := range tt.keys {
+				values[i] = strconv.Itoa(k)
+			}
+			itertest.Seq2(t, tt.name, tt.seq(tree), tt.keys, values)
+			itertest.Seq2(t, tt.name+" of an empty Tree", tt.seq(New[int, string]()), nil, nil)
+			itertest.Seq2(t, tt.name+" of a single key", tt.seq(build(1)), []int{1}, []string{"1"})
+		})
+	}
+}
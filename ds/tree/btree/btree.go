@@ -0,0 +1,507 @@
+package btree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// # B-Tree - Data Structure
+
+// A B-tree is a balanced search tree whose nodes hold many sorted keys instead of one. A node with k keys has k+1 children, and the keys of the i-th child are all between the (i-1)-th and the i-th key of the node. All leaves are at the same depth.
+
+// The minimum degree t sets the size of the nodes: every node except the root holds between t-1 and 2t-1 keys. So a tree of n keys is only about log_t(n) levels deep, and each level is a binary search inside a contiguous slice. Binary trees follow one pointer per comparison and land on a new cache line almost every time; a B-tree reads whole runs of keys from memory that is already cached, which makes it much faster for large in-memory indexes, and the structure of choice on disk.
+
+// ## Operations:
+// - Get: binary search the keys of the root, then go down into the child between the two keys around the one searched.
+// - Put: go down to a leaf and insert the key there. Full nodes met on the way down are split in two around their middle key, which moves up into the parent, so there is always room for it. The tree only grows in height when the root splits.
+// - Delete: go down to the key, making sure every node entered has at least t keys by borrowing a key from a sibling or merging with it, so a key can always be removed without going back up. A key of an inner node is replaced by its predecessor or successor, which is in a leaf.
+// - Load: build the tree bottom up from sorted keys in O(n), instead of O(n log n) for n calls to Put.
+// - Clone: O(1). The clone and the original share all their nodes, and each of them copies a node before changing it (copy on write), so a clone is a cheap snapshot that later changes of either tree do not affect.
+
+// ## Usages:
+// - Database and file system indexes (B+trees in MySQL InnoDB, PostgreSQL, SQLite, NTFS, ext4, Btrfs).
+// - In-memory ordered maps with better cache behaviour than binary trees, with cheap snapshots for readers.
+
+const (
+	// MinDegree is the smallest minimum degree a BTree can have.
+	MinDegree = 2
+	// DefaultDegree is a minimum degree that fits nodes of small keys in a few cache lines.
+	DefaultDegree = 32
+)
+
+var (
+	// ErrNotSorted is returned by Load for keys that are not in strictly ascending order.
+	ErrNotSorted = errors.New("btree: keys are not sorted")
+	// ErrInvariant is wrapped by the errors Check returns.
+	ErrInvariant = errors.New("btree: invariant violated")
+)
+
+// BTree is an ordered map backed by a B-tree.
+type BTree[K, V any] struct {
+	root   *node[K, V]
+	cmp    func(a, b K) int
+	degree int
+	size   int
+	// cow marks the nodes this tree owns and may change in place. Any other node is shared with a clone
+	// and is copied before it is changed.
+	cow *owner
+}
+
+// owner is the identity of a BTree among the trees that share nodes. It must not be zero sized,
+// so that every new owner has a distinct address.
+type owner struct {
+	_ byte
+}
+
+// node is a node of a BTree. Leaves have no children.
+type node[K, V any] struct {
+	items    []item[K, V]
+	children []*node[K, V]
+	cow      *owner
+}
+
+// item is a key with its value.
+type item[K, V any] struct {
+	key   K
+	value V
+}
+
+// New returns an empty BTree of the given minimum degree, at least MinDegree, ordered by the natural order of K.
+func New[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	return NewFunc[K, V](degree, cmp.Compare[K])
+}
+
+// NewFunc returns an empty BTree of the given minimum degree, at least MinDegree, ordered by cmp,
+// which returns a negative number when a < b, a positive number when a > b and zero when a and b are the same key.
+func NewFunc[K, V any](degree int, cmp func(a, b K) int) *BTree[K, V] {
+	return &BTree[K, V]{cmp: cmp, degree: max(degree, MinDegree), cow: &owner{}}
+}
+
+// Get - Returns the value of a key, false if the key is not in the BTree.
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.find(n, key)
+		if found {
+			return n.items[i].value, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var empty V
+	return empty, false
+}
+
+// Contains - Checks if a key is in the BTree.
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put - Adds a key with its value, replacing the value if the key is already in the BTree.
+func (t *BTree[K, V]) Put(key K, value V) {
+	it := item[K, V]{key: key, value: value}
+	if t.root == nil {
+		t.root = t.newNode()
+		t.root.items = append(t.root.items, it)
+		t.size++
+		return
+	}
+	t.root = t.mutable(t.root)
+	if len(t.root.items) == t.maxItems() {
+		mid, right := t.split(t.root)
+		root := t.newNode()
+		root.items = append(root.items, mid)
+		root.children = append(root.children, t.root, right)
+		t.root = root
+	}
+	if !t.insert(t.root, it) {
+		t.size++
+	}
+}
+
+// Delete - Removes a key and its value, false if the key is not in the BTree.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+	t.root = t.mutable(t.root)
+	_, removed := t.remove(t.root, &key, removeKey)
+	if len(t.root.items) == 0 {
+		// The root lost its last key to a merge of its two children, which becomes the new root.
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Min - Returns the smallest key and its value, false if the BTree is empty.
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return entry[K, V](nil)
+	}
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return entry(&n.items[0])
+}
+
+// Max - Returns the largest key and its value, false if the BTree is empty.
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return entry[K, V](nil)
+	}
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return entry(&n.items[len(n.items)-1])
+}
+
+// Len - Returns the number of keys in the BTree.
+func (t *BTree[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty - Checks if the BTree is empty.
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Degree - Returns the minimum degree of the BTree.
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Clear - Removes all keys from the BTree. Clones are not affected.
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Clone - Returns a copy of the BTree in O(1). Both trees share their nodes until they change them,
+// so changes to one are never seen by the other.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	c := *t
+	// Neither tree owns the shared nodes anymore, so both copy them before changing them.
+	t.cow, c.cow = &owner{}, &owner{}
+	return &c
+}
+
+// Load - Replaces the keys of the BTree with the given keys and values, which must be sorted in strictly
+// ascending order of keys. It builds the tree bottom up in O(n) and leaves the BTree unchanged if they are not sorted.
+func (t *BTree[K, V]) Load(items iter.Seq2[K, V]) error {
+	var sorted []item[K, V]
+	for key, value := range items {
+		if len(sorted) > 0 && t.cmp(sorted[len(sorted)-1].key, key) >= 0 {
+			return ErrNotSorted
+		}
+		sorted = append(sorted, item[K, V]{key: key, value: value})
+	}
+	t.root, t.size = nil, len(sorted)
+	if len(sorted) == 0 {
+		return nil
+	}
+	// The tree is as low as possible: a tree of height h holds up to (2t)^h - 1 keys.
+	height, capacity := 1, 2*t.degree
+	for capacity-1 < len(sorted) {
+		height++
+		capacity *= 2 * t.degree
+	}
+	t.root = t.build(sorted, height, true)
+	return nil
+}
+
+// String - Returns a string representation of the BTree, in ascending order of keys.
+func (t *BTree[K, V]) String() string {
+	items := make([]string, 0, t.size)
+	for key, value := range t.All() {
+		items = append(items, fmt.Sprintf("%v:%v", key, value))
+	}
+	return "map[" + strings.Join(items, " ") + "]"
+}
+
+// Check - Checks that the keys are in order, that every node but the root holds between t-1 and 2t-1 keys,
+// that inner nodes have one more child than keys, that all leaves are at the same depth and that the
+// BTree holds Len keys. It returns an error wrapping ErrInvariant for the first problem found.
+func (t *BTree[K, V]) Check() error {
+	count, leafDepth := 0, -1
+	var prev *item[K, V]
+	var walk func(n *node[K, V], depth int) error
+	walk = func(n *node[K, V], depth int) error {
+		if n != t.root && (len(n.items) < t.minItems() || len(n.items) > t.maxItems()) {
+			return fmt.Errorf("%w: node with %d keys, want %d to %d", ErrInvariant, len(n.items), t.minItems(), t.maxItems())
+		}
+		if n.leaf() {
+			if leafDepth >= 0 && depth != leafDepth {
+				return fmt.Errorf("%w: leaves at depths %d and %d", ErrInvariant, leafDepth, depth)
+			}
+			leafDepth = depth
+		} else if len(n.children) != len(n.items)+1 {
+			return fmt.Errorf("%w: node with %d keys has %d children", ErrInvariant, len(n.items), len(n.children))
+		}
+		for i := range n.items {
+			if !n.leaf() {
+				if err := walk(n.children[i], depth+1); err != nil {
+					return err
+				}
+			}
+			if prev != nil && t.cmp(prev.key, n.items[i].key) >= 0 {
+				return fmt.Errorf("%w: key %v is not smaller than the next key %v", ErrInvariant, prev.key, n.items[i].key)
+			}
+			prev = &n.items[i]
+			count++
+		}
+		if !n.leaf() {
+			return walk(n.children[len(n.items)], depth+1)
+		}
+		return nil
+	}
+	if t.root != nil {
+		if len(t.root.items) == 0 || len(t.root.items) > t.maxItems() {
+			return fmt.Errorf("%w: root with %d keys", ErrInvariant, len(t.root.items))
+		}
+		if err := walk(t.root, 0); err != nil {
+			return err
+		}
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: holds %d keys but Len is %d", ErrInvariant, count, t.size)
+	}
+	return nil
+}
+
+func (t *BTree[K, V]) minItems() int {
+	return t.degree - 1
+}
+
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+// newNode returns an empty node owned by t.
+func (t *BTree[K, V]) newNode() *node[K, V] {
+	return &node[K, V]{items: make([]item[K, V], 0, t.maxItems()), cow: t.cow}
+}
+
+// mutable returns n if t owns it, otherwise a copy of n owned by t.
+func (t *BTree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.cow == t.cow {
+		return n
+	}
+	c := t.newNode()
+	c.items = append(c.items, n.items...)
+	if !n.leaf() {
+		c.children = make([]*node[K, V], len(n.children), t.maxItems()+1)
+		copy(c.children, n.children)
+	}
+	return c
+}
+
+// mutableChild makes the i-th child of n, which t must own, owned by t and returns it.
+func (t *BTree[K, V]) mutableChild(n *node[K, V], i int) *node[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+// find returns the index of key in the items of n and true, or the index of the child that may hold it and false.
+func (t *BTree[K, V]) find(n *node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.items, key, func(it item[K, V], key K) int { return t.cmp(it.key, key) })
+}
+
+// split splits a full node n, which t must own, around its middle item. n keeps the first half,
+// and the middle item and a new node with the second half are returned.
+func (t *BTree[K, V]) split(n *node[K, V]) (item[K, V], *node[K, V]) {
+	i := t.degree - 1
+	mid := n.items[i]
+	right := t.newNode()
+	right.items = append(right.items, n.items[i+1:]...)
+	clear(n.items[i:])
+	n.items = n.items[:i]
+	if !n.leaf() {
+		right.children = make([]*node[K, V], 0, t.maxItems()+1)
+		right.children = append(right.children, n.children[i+1:]...)
+		clear(n.children[i+1:])
+		n.children = n.children[:i+1]
+	}
+	return mid, right
+}
+
+// insert adds it to the subtree of n, which t must own and which is not full.
+// It reports whether it replaced an item with the same key.
+func (t *BTree[K, V]) insert(n *node[K, V], it item[K, V]) bool {
+	i, found := t.find(n, it.key)
+	if found {
+		n.items[i] = it
+		return true
+	}
+	if n.leaf() {
+		n.items = slices.Insert(n.items, i, it)
+		return false
+	}
+	if len(n.children[i].items) == t.maxItems() {
+		mid, right := t.split(t.mutableChild(n, i))
+		n.items = slices.Insert(n.items, i, mid)
+		n.children = slices.Insert(n.children, i+1, right)
+		switch c := t.cmp(it.key, mid.key); {
+		case c == 0:
+			n.items[i] = it
+			return true
+		case c > 0:
+			i++
+		}
+	}
+	return t.insert(t.mutableChild(n, i), it)
+}
+
+// removeKind is what remove removes.
+type removeKind int
+
+const (
+	removeKey removeKind = iota
+	removeMin
+	removeMax
+)
+
+// remove removes an item from the subtree of n, which t must own and which has more than t-1 items unless
+// it is the root: the item of key, or the smallest or largest item. It returns the item removed and true,
+// or false if there is none.
+func (t *BTree[K, V]) remove(n *node[K, V], key *K, kind removeKind) (item[K, V], bool) {
+	var i int
+	var found bool
+	switch kind {
+	case removeMin:
+		if n.leaf() {
+			return t.removeAt(n, 0), true
+		}
+	case removeMax:
+		if n.leaf() {
+			return t.removeAt(n, len(n.items)-1), true
+		}
+		i = len(n.items)
+	default:
+		i, found = t.find(n, *key)
+		if n.leaf() {
+			if found {
+				return t.removeAt(n, i), true
+			}
+			return item[K, V]{}, false
+		}
+	}
+	if len(n.children[i].items) <= t.minItems() {
+		// Make sure the child keeps enough items after the removal, then look again as items moved.
+		t.grow(n, i)
+		return t.remove(n, key, kind)
+	}
+	child := t.mutableChild(n, i)
+	if found {
+		// The item is replaced by its predecessor, the largest item of the child on its left.
+		removed := n.items[i]
+		n.items[i], _ = t.remove(child, nil, removeMax)
+		return removed, true
+	}
+	return t.remove(child, key, kind)
+}
+
+// removeAt removes and returns the i-th item of the leaf n.
+func (t *BTree[K, V]) removeAt(n *node[K, V], i int) item[K, V] {
+	it := n.items[i]
+	n.items = slices.Delete(n.items, i, i+1)
+	return it
+}
+
+// grow gives the i-th child of n, which t must own, one more item: it borrows one from a sibling
+// through n if the sibling can spare it, or merges the child with a sibling and the item of n between them.
+func (t *BTree[K, V]) grow(n *node[K, V], i int) {
+	switch {
+	case i > 0 && len(n.children[i-1].items) > t.minItems():
+		child, left := t.mutableChild(n, i), t.mutableChild(n, i-1)
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = t.removeAt(left, len(left.items)-1)
+		if !left.leaf() {
+			last := len(left.children) - 1
+			child.children = slices.Insert(child.children, 0, left.children[last])
+			left.children[last] = nil
+			left.children = left.children[:last]
+		}
+	case i < len(n.items) && len(n.children[i+1].items) > t.minItems():
+		child, right := t.mutableChild(n, i), t.mutableChild(n, i+1)
+		child.items = append(child.items, n.items[i])
+		n.items[i] = t.removeAt(right, 0)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	default:
+		if i == len(n.items) {
+			i--
+		}
+		child, right := t.mutableChild(n, i), n.children[i+1]
+		child.items = append(child.items, n.items[i])
+		child.items = append(child.items, right.items...)
+		child.children = append(child.children, right.children...)
+		n.items = slices.Delete(n.items, i, i+1)
+		n.children = slices.Delete(n.children, i+1, i+2)
+	}
+}
+
+// build returns a subtree of the given height holding the sorted items, spreading them evenly so that every
+// node but the root holds at least t-1 items.
+func (t *BTree[K, V]) build(items []item[K, V], height int, root bool) *node[K, V] {
+	n := t.newNode()
+	if height == 1 {
+		n.items = append(n.items, items...)
+		return n
+	}
+	// A subtree of height h-1 holds up to (2t)^(h-1) - 1 items and, unless it is the root, at least t^(h-1) - 1.
+	capacity := 1
+	for range height - 1 {
+		capacity *= 2 * t.degree
+	}
+	children := (len(items) + capacity) / capacity
+	if !root {
+		children = max(children, t.degree)
+	}
+	n.children = make([]*node[K, V], 0, t.maxItems()+1)
+	share, extra := (len(items)-children+1)/children, (len(items)-children+1)%children
+	for i := range children {
+		size := share
+		if i < extra {
+			size++
+		}
+		n.children = append(n.children, t.build(items[:size], height-1, false))
+		items = items[size:]
+		if i < children-1 {
+			n.items = append(n.items, items[0])
+			items = items[1:]
+		}
+	}
+	return n
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// entry returns the key and value of it, false if it is nil.
+func entry[K, V any](it *item[K, V]) (K, V, bool) {
+	if it == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return it.key, it.value, true
+}
@@ -0,0 +1,160 @@
+package btree
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/maptest"
+)
+
+var degrees = []int{MinDegree, 3, DefaultDegree}
+
+// TestRandomized checks the BTree against a reference map after random Puts and Deletes at several degrees,
+// along with the bounded iterators. It takes Clones along the way and checks later changes never reach them.
+func TestRandomized(t *testing.T) {
+	for _, degree := range degrees {
+		for seed := range uint64(3) {
+			t.Run(fmt.Sprintf("degree=%d/seed=%d", degree, seed), func(t *testing.T) {
+				tr := New[int, int](degree)
+				type snapshot struct {
+					tree *BTree[int, int]
+					ref  map[int]int
+				}
+				var snapshots []snapshot
+				maptest.Randomized(t, tr, seed, 4000, func(step int, ref map[int]int, keys []int, r *rand.Rand) {
+					compareBounded(t, tr, keys, r)
+					if step%500 == 0 {
+						snapshots = append(snapshots, snapshot{tr.Clone(), maps.Clone(ref)})
+					}
+				})
+				r := rand.New(rand.NewPCG(seed, seed))
+				for i, s := range snapshots {
+					if err := s.tree.Check(); err != nil {
+						t.Fatalf("snapshot %d: %v", i, err)
+					}
+					maptest.Compare(t, s.tree, s.ref, r)
+				}
+			})
+		}
+	}
+}
+
+// TestCloneIndependent changes a Clone and the original differently and checks neither sees the other.
+func TestCloneIndependent(t *testing.T) {
+	for _, degree := range degrees {
+		tr := New[int, int](degree)
+		for key := range maptest.KeySpace {
+			tr.Put(key, key)
+		}
+		c := tr.Clone()
+		for key := 0; key < maptest.KeySpace; key += 2 {
+			tr.Delete(key)
+			c.Put(key, -key)
+		}
+		for key := range maptest.KeySpace {
+			value, ok := tr.Get(key)
+			if ok != (key%2 == 1) || ok && value != key {
+				t.Fatalf("degree %d: original Get(%d) = %d, %v", degree, key, value, ok)
+			}
+			if value, ok := c.Get(key); !ok || key%2 == 0 && value != -key || key%2 == 1 && value != key {
+				t.Fatalf("degree %d: clone Get(%d) = %d, %v", degree, key, value, ok)
+			}
+		}
+		if err := tr.Check(); err != nil {
+			t.Fatalf("degree %d: original: %v", degree, err)
+		}
+		if err := c.Check(); err != nil {
+			t.Fatalf("degree %d: clone: %v", degree, err)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	for _, degree := range degrees {
+		for _, n := range []int{0, 1, 2*degree - 1, 2 * degree, 1000} {
+			tr, ref := New[int, int](degree), map[int]int{}
+			keys := make([]int, n)
+			for i := range keys {
+				keys[i] = 3 * i
+				ref[keys[i]] = i
+			}
+			if err := tr.Load(pairs(keys)); err != nil {
+				t.Fatalf("degree %d, n %d: Load() = %v", degree, n, err)
+			}
+			if err := tr.Check(); err != nil {
+				t.Fatalf("degree %d, n %d: %v", degree, n, err)
+			}
+			maptest.Compare(t, tr, ref, r)
+			// A tree built by Load must stay valid under later changes.
+			for i := range 50 {
+				tr.Put(3*i+1, 0)
+				tr.Delete(3 * i)
+				if err := tr.Check(); err != nil {
+					t.Fatalf("degree %d, n %d: after Load: %v", degree, n, err)
+				}
+			}
+		}
+	}
+	tr := New[int, int](MinDegree)
+	tr.Put(1, 1)
+	if err := tr.Load(pairs([]int{1, 3, 2})); !errors.Is(err, ErrNotSorted) {
+		t.Fatalf("Load(unsorted) = %v, want ErrNotSorted", err)
+	}
+	if err := tr.Load(pairs([]int{1, 1})); !errors.Is(err, ErrNotSorted) {
+		t.Fatalf("Load(duplicates) = %v, want ErrNotSorted", err)
+	}
+	if value, ok := tr.Get(1); tr.Len() != 1 || !ok || value != 1 {
+		t.Fatal("Load() changed the BTree although it failed")
+	}
+}
+
+// compareBounded checks Ascend, AscendFrom, Descend and DescendFrom at random bounds against the sorted keys.
+func compareBounded(t *testing.T, tr *BTree[int, int], keys []int, r *rand.Rand) {
+	t.Helper()
+	lo := maptest.Key(r)
+	hi := lo + r.IntN(maptest.KeySpace/4)
+	var ascend, from, descend, down []int
+	for _, key := range keys {
+		if key >= lo && key <= hi {
+			ascend = append(ascend, key)
+		}
+		if key >= lo {
+			from = append(from, key)
+		}
+		if key <= hi {
+			down = append(down, key)
+		}
+	}
+	descend = slices.Clone(ascend)
+	slices.Reverse(descend)
+	slices.Reverse(down)
+	if got := maptest.Keys(tr.Ascend(lo, hi)); !slices.Equal(got, ascend) {
+		t.Fatalf("Ascend(%d, %d) = %v, want %v", lo, hi, got, ascend)
+	}
+	if got := maptest.Keys(tr.AscendFrom(lo)); !slices.Equal(got, from) {
+		t.Fatalf("AscendFrom(%d) = %v, want %v", lo, got, from)
+	}
+	if got := maptest.Keys(tr.Descend(hi, lo)); !slices.Equal(got, descend) {
+		t.Fatalf("Descend(%d, %d) = %v, want %v", hi, lo, got, descend)
+	}
+	if got := maptest.Keys(tr.DescendFrom(hi)); !slices.Equal(got, down) {
+		t.Fatalf("DescendFrom(%d) = %v, want %v", hi, got, down)
+	}
+}
+
+// pairs returns an iterator over keys, each with its index as value.
+func pairs(keys []int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i, key := range keys {
+			if !yield(key, i) {
+				return
+			}
+		}
+	}
+}
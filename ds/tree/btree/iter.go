@@ -0,0 +1,105 @@
+package btree
+
+import "iter"
+
+// All - Returns an iterator over the keys and values in ascending order of keys.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Backward - Returns an iterator over the keys and values in descending order of keys.
+func (t *BTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, nil, nil, yield)
+	}
+}
+
+// Ascend - Returns an iterator over the keys from lo up to hi, both included, and their values.
+func (t *BTree[K, V]) Ascend(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &lo, &hi, yield)
+	}
+}
+
+// AscendFrom - Returns an iterator over the keys from lo up to the largest key, and their values.
+func (t *BTree[K, V]) AscendFrom(lo K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &lo, nil, yield)
+	}
+}
+
+// Descend - Returns an iterator over the keys from hi down to lo, both included, and their values.
+func (t *BTree[K, V]) Descend(hi, lo K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, &hi, &lo, yield)
+	}
+}
+
+// DescendFrom - Returns an iterator over the keys from hi down to the smallest key, and their values.
+func (t *BTree[K, V]) DescendFrom(hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, &hi, nil, yield)
+	}
+}
+
+// ascend yields the items of the subtree of n from lo up to hi, when they are not nil.
+// It returns false once it is past hi or yield asked to stop.
+func (t *BTree[K, V]) ascend(n *node[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	start, found := 0, false
+	if lo != nil {
+		start, found = t.find(n, *lo)
+	}
+	for i := start; i < len(n.items); i++ {
+		// The child before an item equal to lo only holds smaller keys.
+		if !n.leaf() && !(found && i == start) && !t.ascend(n.children[i], lo, hi, yield) {
+			return false
+		}
+		it := &n.items[i]
+		if hi != nil && t.cmp(it.key, *hi) > 0 {
+			return false
+		}
+		if !yield(it.key, it.value) {
+			return false
+		}
+	}
+	if !n.leaf() {
+		return t.ascend(n.children[len(n.items)], lo, hi, yield)
+	}
+	return true
+}
+
+// descend yields the items of the subtree of n from hi down to lo, when they are not nil.
+// It returns false once it is past lo or yield asked to stop.
+func (t *BTree[K, V]) descend(n *node[K, V], hi, lo *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	// end is the number of items not above hi. The child after an item equal to hi only holds larger keys.
+	end, found := len(n.items), false
+	if hi != nil {
+		if end, found = t.find(n, *hi); found {
+			end++
+		}
+	}
+	if !n.leaf() && !found && !t.descend(n.children[end], hi, lo, yield) {
+		return false
+	}
+	for i := end - 1; i >= 0; i-- {
+		it := &n.items[i]
+		if lo != nil && t.cmp(it.key, *lo) < 0 {
+			return false
+		}
+		if !yield(it.key, it.value) {
+			return false
+		}
+		if !n.leaf() && !t.descend(n.children[i], hi, lo, yield) {
+			return false
+		}
+	}
+	return true
+}
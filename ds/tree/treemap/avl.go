@@ -0,0 +1,166 @@
+package treemap
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// AVL is an OrderedMap backed by an AVL tree.
+type AVL[K, V any] struct {
+	tree[K, V]
+}
+
+// NewAVL returns an empty AVL ordered by the natural order of K.
+func NewAVL[K cmp.Ordered, V any]() *AVL[K, V] {
+	return NewAVLFunc[K, V](cmp.Compare[K])
+}
+
+// NewAVLFunc returns an empty AVL ordered by cmp, which returns a negative number when a < b,
+// a positive number when a > b and zero when a and b are the same key.
+func NewAVLFunc[K, V any](cmp func(a, b K) int) *AVL[K, V] {
+	return &AVL[K, V]{tree[K, V]{cmp: cmp}}
+}
+
+// Put - Adds a key with its value, replacing the value if the key is already in the map.
+func (t *AVL[K, V]) Put(key K, value V) {
+	t.root = t.insert(t.root, key, value)
+}
+
+// Delete - Removes a key and its value, false if the key is not in the map.
+func (t *AVL[K, V]) Delete(key K) bool {
+	n := t.Len()
+	t.root = t.delete(t.root, key)
+	return t.Len() < n
+}
+
+// Height - Returns the number of nodes on the longest path from the root to a leaf, 0 if the map is empty.
+func (t *AVL[K, V]) Height() int {
+	return height(t.root)
+}
+
+// Check - Checks that the keys are in order, that every node has the right size and height and that the
+// heights of the two subtrees of every node differ by at most 1.
+func (t *AVL[K, V]) Check() error {
+	if err := t.checkOrder(); err != nil {
+		return err
+	}
+	var walk func(n *node[K, V]) error
+	walk = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if err := walk(n.left); err != nil {
+			return err
+		}
+		if err := walk(n.right); err != nil {
+			return err
+		}
+		if want := 1 + max(height(n.left), height(n.right)); n.height != want {
+			return fmt.Errorf("%w: node %v has height %d, want %d", ErrInvariant, n.key, n.height, want)
+		}
+		if balance := height(n.left) - height(n.right); balance < -1 || balance > 1 {
+			return fmt.Errorf("%w: node %v has balance factor %d", ErrInvariant, n.key, balance)
+		}
+		return nil
+	}
+	return walk(t.root)
+}
+
+func (t *AVL[K, V]) insert(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, height: 1}
+	}
+	c := t.cmp(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.insert(n.left, key, value)
+	case c > 0:
+		n.right = t.insert(n.right, key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return rebalance(n)
+}
+
+func (t *AVL[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	c := t.cmp(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.delete(n.left, key)
+	case c > 0:
+		n.right = t.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		var successor *node[K, V]
+		n.right, successor = deleteMinAVL(n.right)
+		successor.left, successor.right = n.left, n.right
+		n = successor
+	}
+	return rebalance(n)
+}
+
+// deleteMinAVL detaches the smallest node of the subtree and returns the new subtree root and that node.
+func deleteMinAVL[K, V any](n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var first *node[K, V]
+	n.left, first = deleteMinAVL(n.left)
+	return rebalance(n), first
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and size of n from its children.
+func update[K, V any](n *node[K, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.size = 1 + size(n.left) + size(n.right)
+}
+
+// rebalance restores the AVL balance of n after one of its subtrees changed and returns the new root.
+func rebalance[K, V any](n *node[K, V]) *node[K, V] {
+	update(n)
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeftAVL(n.left)
+		}
+		return rotateRightAVL(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRightAVL(n.right)
+		}
+		return rotateLeftAVL(n)
+	}
+	return n
+}
+
+func rotateLeftAVL[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	update(n)
+	update(r)
+	return r
+}
+
+func rotateRightAVL[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	update(n)
+	update(l)
+	return l
+}
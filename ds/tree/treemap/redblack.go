@@ -0,0 +1,207 @@
+package treemap
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// RedBlack is an OrderedMap backed by a left-leaning red-black tree.
+type RedBlack[K, V any] struct {
+	tree[K, V]
+}
+
+// NewRedBlack returns an empty RedBlack ordered by the natural order of K.
+func NewRedBlack[K cmp.Ordered, V any]() *RedBlack[K, V] {
+	return NewRedBlackFunc[K, V](cmp.Compare[K])
+}
+
+// NewRedBlackFunc returns an empty RedBlack ordered by cmp, which returns a negative number when a < b,
+// a positive number when a > b and zero when a and b are the same key.
+func NewRedBlackFunc[K, V any](cmp func(a, b K) int) *RedBlack[K, V] {
+	return &RedBlack[K, V]{tree[K, V]{cmp: cmp}}
+}
+
+// Put - Adds a key with its value, replacing the value if the key is already in the map.
+func (t *RedBlack[K, V]) Put(key K, value V) {
+	t.root = t.insert(t.root, key, value)
+	t.root.red = false
+}
+
+// Delete - Removes a key and its value, false if the key is not in the map.
+func (t *RedBlack[K, V]) Delete(key K) bool {
+	if !t.Contains(key) {
+		return false
+	}
+	// The deletion moves a red link down the search path, so that the node removed at the bottom is red.
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = t.delete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+	return true
+}
+
+// Check - Checks that the keys are in order, that every node has the right size, that the root is black,
+// that red links lean left and never follow each other, and that every path from the root to a leaf has
+// the same number of black links.
+func (t *RedBlack[K, V]) Check() error {
+	if err := t.checkOrder(); err != nil {
+		return err
+	}
+	if isRed(t.root) {
+		return fmt.Errorf("%w: root is red", ErrInvariant)
+	}
+	// walk returns the number of black links on every path from n down to a leaf.
+	var walk func(n *node[K, V]) (int, error)
+	walk = func(n *node[K, V]) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+		if isRed(n.right) {
+			return 0, fmt.Errorf("%w: node %v has a red right link", ErrInvariant, n.key)
+		}
+		if isRed(n) && isRed(n.left) {
+			return 0, fmt.Errorf("%w: node %v and its left child are both red", ErrInvariant, n.key)
+		}
+		left, err := walk(n.left)
+		if err != nil {
+			return 0, err
+		}
+		right, err := walk(n.right)
+		if err != nil {
+			return 0, err
+		}
+		if left != right {
+			return 0, fmt.Errorf("%w: node %v has %d black links on the left and %d on the right", ErrInvariant, n.key, left, right)
+		}
+		if !isRed(n) {
+			left++
+		}
+		return left, nil
+	}
+	_, err := walk(t.root)
+	return err
+}
+
+func (t *RedBlack[K, V]) insert(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, red: true}
+	}
+	c := t.cmp(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.insert(n.left, key, value)
+	case c > 0:
+		n.right = t.insert(n.right, key, value)
+	default:
+		n.value = value
+	}
+	return fixUp(n)
+}
+
+// delete removes key, which must be in the subtree of n.
+func (t *RedBlack[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if t.cmp(key, n.key) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = t.delete(n.left, key)
+		return fixUp(n)
+	}
+	if isRed(n.left) {
+		n = rotateRightRB(n)
+	}
+	if t.cmp(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+	if t.cmp(key, n.key) == 0 {
+		successor := leftmost(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = deleteMinRB(n.right)
+	} else {
+		n.right = t.delete(n.right, key)
+	}
+	return fixUp(n)
+}
+
+// deleteMinRB removes the smallest node of the subtree of n and returns the new subtree root.
+func deleteMinRB[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = deleteMinRB(n.left)
+	return fixUp(n)
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+// fixUp restores the left-leaning red-black rules at n on the way back up and recomputes its size.
+func fixUp[K, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeftRB(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRightRB(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	n.size = 1 + size(n.left) + size(n.right)
+	return n
+}
+
+// moveRedLeft makes the left child of n or one of its children red, n being red and both its children black.
+func moveRedLeft[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRightRB(n.right)
+		n = rotateLeftRB(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes the right child of n or one of its children red, n being red and both its children black.
+func moveRedRight[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRightRB(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// flipColors flips the color of n and its two children, splitting or merging a temporary 4-node.
+func flipColors[K, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+func rotateLeftRB[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	r.red, n.red = n.red, true
+	r.size = n.size
+	n.size = 1 + size(n.left) + size(n.right)
+	return r
+}
+
+func rotateRightRB[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	l.red, n.red = n.red, true
+	l.size = n.size
+	n.size = 1 + size(n.left) + size(n.right)
+	return l
+}
@@ -0,0 +1,331 @@
+package treemap
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// # Self-Balancing Tree Map - Data Structure
+
+// A tree map is a map whose keys are kept sorted in a binary search tree, so besides Get and Put it answers ordered queries: the smallest and largest keys, the keys just below or above a given key, the keys of a range, the position of a key among all keys. A plain binary search tree degenerates into a linked list when keys arrive in sorted order, as they do from database scans; a self-balancing tree restructures itself with rotations on every update so its height stays O(log n), and every operation with it.
+
+// Both trees of this package implement OrderedMap, and every node also stores the size of its subtree, which makes Rank and Select O(log n) too.
+
+// ## AVL tree (Adelson-Velsky and Landis):
+// Every node stores its height, and the heights of its two subtrees differ by at most 1. An update that breaks this at a node is fixed by one or two rotations there, on the way back up. AVL trees are the more rigidly balanced of the two (height below 1.44 log n), so lookups are slightly faster.
+
+// ## Left-leaning red-black tree (Sedgewick):
+// A red-black tree colors every link red or black: every path from the root to a leaf has the same number of black links, and no path has two red links in a row. Left-leaning red-black trees also require red links to lean left, which makes them the binary form of 2-3 trees: a node with a red left link is a 3-node. Updates restore the rules with rotations and color flips. Height is below 2 log n, and updates restructure the tree less often than AVL trees.
+
+// ## Usages:
+// - Ordered maps of language runtimes: Java TreeMap and C++ std::map are red-black trees.
+// - In-memory indexes, schedulers and event queues that need range queries, order statistics or the nearest key.
+// - Interval and segment trees, built by augmenting the nodes of a balanced tree.
+
+// OrderedMap is a map whose keys are kept in order.
+type OrderedMap[K, V any] interface {
+	// Put - Adds a key with its value, replacing the value if the key is already in the map.
+	Put(key K, value V)
+	// Get - Returns the value of a key, false if the key is not in the map.
+	Get(key K) (V, bool)
+	// Delete - Removes a key and its value, false if the key is not in the map.
+	Delete(key K) bool
+	// Floor - Returns the largest key less than or equal to key and its value, false if there is none.
+	Floor(key K) (K, V, bool)
+	// Ceiling - Returns the smallest key greater than or equal to key and its value, false if there is none.
+	Ceiling(key K) (K, V, bool)
+	// Range - Returns an iterator over the keys between lo and hi, both included, in ascending order.
+	Range(lo, hi K) iter.Seq2[K, V]
+	// Rank - Returns the number of keys less than key.
+	Rank(key K) int
+	// Select - Returns the key with the given rank, the k-th smallest counting from 0, false if k is not in [0, Len()).
+	Select(k int) (K, V, bool)
+	// Min - Returns the smallest key and its value, false if the map is empty.
+	Min() (K, V, bool)
+	// Max - Returns the largest key and its value, false if the map is empty.
+	Max() (K, V, bool)
+	// All - Returns an iterator over the keys and values in ascending order of keys.
+	All() iter.Seq2[K, V]
+	// Backward - Returns an iterator over the keys and values in descending order of keys.
+	Backward() iter.Seq2[K, V]
+	// Len - Returns the number of keys in the map.
+	Len() int
+	// Check - Checks the invariants of the tree and returns an error wrapping ErrInvariant for the first one broken.
+	Check() error
+}
+
+var (
+	_ OrderedMap[int, int] = (*AVL[int, int])(nil)
+	_ OrderedMap[int, int] = (*RedBlack[int, int])(nil)
+)
+
+// ErrInvariant is wrapped by the errors Check returns.
+var ErrInvariant = errors.New("treemap: invariant violated")
+
+// node is a node of an AVL or RedBlack tree.
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	// size is the number of nodes of the subtree.
+	size int
+	// height is the number of nodes on the longest path down to a leaf, only kept by AVL.
+	height int
+	// red is the color of the link from the parent, only kept by RedBlack.
+	red bool
+}
+
+// tree holds what AVL and RedBlack share: the root, the order of the keys and the queries, which do not
+// depend on how the tree is balanced.
+type tree[K, V any] struct {
+	root *node[K, V]
+	cmp  func(a, b K) int
+}
+
+// Get - Returns the value of a key, false if the key is not in the map.
+func (t *tree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		c := t.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var empty V
+	return empty, false
+}
+
+// Contains - Checks if a key is in the map.
+func (t *tree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Floor - Returns the largest key less than or equal to key and its value, false if there is none.
+func (t *tree[K, V]) Floor(key K) (K, V, bool) {
+	var floor *node[K, V]
+	for n := t.root; n != nil; {
+		c := t.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			floor, n = n, n.right
+		default:
+			return entry(n)
+		}
+	}
+	return entry(floor)
+}
+
+// Ceiling - Returns the smallest key greater than or equal to key and its value, false if there is none.
+func (t *tree[K, V]) Ceiling(key K) (K, V, bool) {
+	var ceiling *node[K, V]
+	for n := t.root; n != nil; {
+		c := t.cmp(key, n.key)
+		switch {
+		case c < 0:
+			ceiling, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return entry(n)
+		}
+	}
+	return entry(ceiling)
+}
+
+// Rank - Returns the number of keys less than key.
+func (t *tree[K, V]) Rank(key K) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		c := t.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += size(n.left) + 1
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+	return rank
+}
+
+// Select - Returns the key with the given rank, the k-th smallest counting from 0, and its value.
+// It returns false if k is not in [0, Len()).
+func (t *tree[K, V]) Select(k int) (K, V, bool) {
+	for n := t.root; n != nil; {
+		left := size(n.left)
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return entry(n)
+		}
+	}
+	return entry[K, V](nil)
+}
+
+// Min - Returns the smallest key and its value, false if the map is empty.
+func (t *tree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return entry[K, V](nil)
+	}
+	return entry(leftmost(t.root))
+}
+
+// Max - Returns the largest key and its value, false if the map is empty.
+func (t *tree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	if n == nil {
+		return entry[K, V](nil)
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return entry(n)
+}
+
+// Range - Returns an iterator over the keys between lo and hi, both included, and their values in ascending order.
+func (t *tree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &lo, &hi, yield)
+	}
+}
+
+// All - Returns an iterator over the keys and values in ascending order of keys.
+func (t *tree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Backward - Returns an iterator over the keys and values in descending order of keys.
+func (t *tree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(t.root, yield)
+	}
+}
+
+// Keys - Returns a slice of all keys in ascending order.
+func (t *tree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.Len())
+	for key := range t.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Len - Returns the number of keys in the map.
+func (t *tree[K, V]) Len() int {
+	return size(t.root)
+}
+
+// IsEmpty - Checks if the map is empty.
+func (t *tree[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear - Removes all keys from the map.
+func (t *tree[K, V]) Clear() {
+	t.root = nil
+}
+
+// String - Returns a string representation of the map, in ascending order of keys.
+func (t *tree[K, V]) String() string {
+	items := make([]string, 0, t.Len())
+	for key, value := range t.All() {
+		items = append(items, fmt.Sprintf("%v:%v", key, value))
+	}
+	return "map[" + strings.Join(items, " ") + "]"
+}
+
+// ascend yields the keys and values of the subtree in ascending order, limited to [lo, hi] when they are not nil.
+// It returns false once yield asked to stop.
+func (t *tree[K, V]) ascend(n *node[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || t.cmp(n.key, *lo) >= 0
+	belowHi := hi == nil || t.cmp(n.key, *hi) <= 0
+	if aboveLo && !t.ascend(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return t.ascend(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+// checkOrder checks that the keys are in strictly ascending order and that every node has the right size.
+func (t *tree[K, V]) checkOrder() error {
+	var prev *node[K, V]
+	var walk func(n *node[K, V]) error
+	walk = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if err := walk(n.left); err != nil {
+			return err
+		}
+		if prev != nil && t.cmp(prev.key, n.key) >= 0 {
+			return fmt.Errorf("%w: key %v is not smaller than the next key %v", ErrInvariant, prev.key, n.key)
+		}
+		prev = n
+		if err := walk(n.right); err != nil {
+			return err
+		}
+		if n.size != 1+size(n.left)+size(n.right) {
+			return fmt.Errorf("%w: node %v has size %d, want %d", ErrInvariant, n.key, n.size, 1+size(n.left)+size(n.right))
+		}
+		return nil
+	}
+	return walk(t.root)
+}
+
+// descend yields the keys and values of the subtree in descending order.
+func descend[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return descend(n.right, yield) && yield(n.key, n.value) && descend(n.left, yield)
+}
+
+// leftmost returns the node with the smallest key of the subtree.
+func leftmost[K, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// entry returns the key and value of n, false if n is nil.
+func entry[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
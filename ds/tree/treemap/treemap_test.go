@@ -0,0 +1,115 @@
+package treemap
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/rama-kairi/ds-algo/ds/internal/maptest"
+)
+
+var implementations = []struct {
+	name string
+	new  func() OrderedMap[int, int]
+}{
+	{"AVL", func() OrderedMap[int, int] { return NewAVL[int, int]() }},
+	{"RedBlack", func() OrderedMap[int, int] { return NewRedBlack[int, int]() }},
+}
+
+// TestRandomized checks every OrderedMap against a reference map after random Puts and Deletes, along
+// with the order statistics and range queries the shared harness does not know about.
+func TestRandomized(t *testing.T) {
+	for _, impl := range implementations {
+		for seed := range uint64(4) {
+			t.Run(fmt.Sprintf("%s/seed=%d", impl.name, seed), func(t *testing.T) {
+				m := impl.new()
+				maptest.Randomized(t, m, seed, 4000, func(_ int, _ map[int]int, keys []int, r *rand.Rand) {
+					compareQueries(t, m, keys, r)
+				})
+			})
+		}
+	}
+}
+
+// TestSortedInput inserts and deletes keys in ascending and descending order, which degenerates a binary
+// search tree that is not balanced.
+func TestSortedInput(t *testing.T) {
+	for _, impl := range implementations {
+		t.Run(impl.name, func(t *testing.T) {
+			m, ref := impl.new(), map[int]int{}
+			for key := range maptest.KeySpace {
+				m.Put(key, key)
+				ref[key] = key
+				if err := m.Check(); err != nil {
+					t.Fatalf("Put(%d): %v", key, err)
+				}
+			}
+			for key := maptest.KeySpace - 1; key >= 0; key -= 2 {
+				m.Delete(key)
+				delete(ref, key)
+				if err := m.Check(); err != nil {
+					t.Fatalf("Delete(%d): %v", key, err)
+				}
+			}
+			r := rand.New(rand.NewPCG(1, 1))
+			compareQueries(t, m, maptest.Compare(t, m, ref, r), r)
+		})
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	for _, impl := range implementations {
+		m := impl.new()
+		if _, _, ok := m.Min(); ok {
+			t.Errorf("%s: Min() on an empty map = true", impl.name)
+		}
+		if _, _, ok := m.Select(0); ok {
+			t.Errorf("%s: Select(0) on an empty map = true", impl.name)
+		}
+		if m.Delete(1) || m.Len() != 0 || m.Check() != nil {
+			t.Errorf("%s: Delete(1) on an empty map changed it", impl.name)
+		}
+	}
+}
+
+// compareQueries checks Rank, Floor, Ceiling, Select and Range at random keys of m against its sorted keys.
+func compareQueries(t *testing.T, m OrderedMap[int, int], keys []int, r *rand.Rand) {
+	t.Helper()
+	for range 4 {
+		key := maptest.Key(r)
+		rank, found := slices.BinarySearch(keys, key)
+		if got := m.Rank(key); got != rank {
+			t.Fatalf("Rank(%d) = %d, want %d", key, got, rank)
+		}
+		floor, _, ok := m.Floor(key)
+		if want := rank - 1; found {
+			if !ok || floor != key {
+				t.Fatalf("Floor(%d) = %d, %v, want the key itself", key, floor, ok)
+			}
+		} else if ok != (want >= 0) || ok && floor != keys[want] {
+			t.Fatalf("Floor(%d) = %d, %v", key, floor, ok)
+		}
+		ceiling, _, ok := m.Ceiling(key)
+		if ok != (rank < len(keys)) || ok && ceiling != keys[rank] {
+			t.Fatalf("Ceiling(%d) = %d, %v", key, ceiling, ok)
+		}
+
+		k := r.IntN(len(keys)+2) - 1
+		selected, _, ok := m.Select(k)
+		if inRange := k >= 0 && k < len(keys); ok != inRange || ok && selected != keys[k] {
+			t.Fatalf("Select(%d) = %d, %v", k, selected, ok)
+		}
+
+		lo, hi := key, key+r.IntN(maptest.KeySpace/4)
+		var want []int
+		for _, key := range keys {
+			if key >= lo && key <= hi {
+				want = append(want, key)
+			}
+		}
+		if got := maptest.Keys(m.Range(lo, hi)); !slices.Equal(got, want) {
+			t.Fatalf("Range(%d, %d) = %v, want %v", lo, hi, got, want)
+		}
+	}
+}
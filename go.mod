@@ -1,3 +1,3 @@
 module github.com/rama-kairi/ds-algo
 
-go 1.18
+go 1.23
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/bloom"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func main() {
+	f := bloom.New(1000, 0.01)
+	f.AddString("alice")
+	f.AddString("bob")
+	fmt.Println(f.TestString("alice"), f.TestString("carol"), f.M(), f.K())
+
+	c := bloom.NewCounting(1000, 0.01)
+	c.AddString("alice")
+	fmt.Println(c.TestString("alice"), c.RemoveString("alice"), c.TestString("alice"))
+
+	s := bloom.NewScalable(100, 0.01)
+	for i := range 1000 {
+		s.AddString(fmt.Sprint(i))
+	}
+	fmt.Println(s.TestString("999"), s.Count(), s.Filters())
+
+	ids := bloom.NewInteger[int](100, 0.01)
+	ids.Add(-7)
+	fmt.Println(ids.Test(-7), ids.Test(7))
+
+	// The varint of the ID ends where the name starts, so different users give different bytes.
+	u := bloom.NewTyped(100, 0.01, func(u user) []byte {
+		return append(binary.AppendUvarint(nil, uint64(u.ID)), u.Name...)
+	})
+	u.Add(user{1, "alice"})
+	fmt.Println(u.Test(user{1, "alice"}), u.Test(user{2, "bob"}))
+
+	data, _ := f.MarshalBinary()
+	g := &bloom.Filter{}
+	fmt.Println(g.UnmarshalBinary(data), g.TestString("bob"))
+}
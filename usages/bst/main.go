@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/tree/bst"
+)
+
+func main() {
+	t := bst.New[int, string]()
+	for _, k := range []int{50, 30, 70, 20, 40, 60, 80} {
+		t.Insert(k, fmt.Sprint("v", k))
+	}
+	fmt.Println(t, t.Len(), t.Height())
+
+	for k := range t.PreOrder() {
+		fmt.Print(k, " ")
+	}
+	fmt.Println()
+	for k := range t.LevelOrder() {
+		fmt.Print(k, " ")
+	}
+	fmt.Println()
+
+	fmt.Println(t.Successor(40))
+	fmt.Println(t.Predecessor(50))
+	fmt.Println(t.Delete(30), t.Contains(30), t.Validate())
+}
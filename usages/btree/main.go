@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/tree/btree"
+)
+
+func main() {
+	t := btree.New[int, string](btree.MinDegree)
+	for i := range 10 {
+		t.Put(i, fmt.Sprint("v", i))
+	}
+	t.Delete(3)
+	fmt.Println(t, t.Len(), t.Check())
+
+	for k := range t.Ascend(2, 6) {
+		fmt.Print(k, " ")
+	}
+	fmt.Println()
+	for k := range t.Descend(6, 2) {
+		fmt.Print(k, " ")
+	}
+	fmt.Println()
+
+	snapshot := t.Clone()
+	t.Put(100, "v100")
+	fmt.Println(t.Len(), snapshot.Len(), snapshot.Contains(100))
+
+	sorted := btree.New[int, int](btree.DefaultDegree)
+	err := sorted.Load(func(yield func(int, int) bool) {
+		for i := range 100000 {
+			if !yield(i, i*i) {
+				return
+			}
+		}
+	})
+	fmt.Println(err, sorted.Len(), sorted.Check())
+	fmt.Println(sorted.Max())
+}
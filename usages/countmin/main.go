@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/countmin"
+)
+
+func main() {
+	width, depth := countmin.EstimateParameters(0.001, 0.01)
+	s := countmin.NewConservative(width, depth, 42)
+	s.AddString("go", 3)
+	s.AddString("rust", 1)
+	fmt.Println(s.EstimateString("go"), s.EstimateString("zig"), s.ErrorBound())
+
+	words := []string{"a", "b", "a", "c", "a", "b", "d", "a", "e", "b"}
+	left := countmin.NewStringTopK[string](2, countmin.New(width, depth, 42))
+	right := countmin.NewStringTopK[string](2, countmin.New(width, depth, 42))
+	for i, w := range words {
+		if i%2 == 0 {
+			left.Add(w)
+		} else {
+			right.Add(w)
+		}
+	}
+	fmt.Println(left.Merge(right), left.Items())
+
+	data, _ := s.MarshalBinary()
+	t := &countmin.Sketch{}
+	fmt.Println(t.UnmarshalBinary(data), t.EstimateString("go"))
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/hll"
+	"github.com/rama-kairi/ds-algo/ds/set"
+)
+
+func main() {
+	visitors := hll.NewStringCounter[string](hll.DefaultPrecision)
+	for i := range 100000 {
+		visitors.Add(fmt.Sprint("user-", i%25000))
+	}
+	fmt.Println(visitors.Count(), visitors.IsSparse())
+
+	ids := set.New[int]()
+	for i := range 500 {
+		ids.Add(i)
+	}
+	fmt.Println(hll.FromSet(hll.NewIntegerCounter[int](12), ids).Count())
+
+	a, b := hll.New(12), hll.New(12)
+	for i := range 3000 {
+		a.AddString(fmt.Sprint(i))
+		b.AddString(fmt.Sprint(i + 1500))
+	}
+	fmt.Println(a.Merge(b), a.Count())
+
+	data, _ := a.MarshalBinary()
+	c := &hll.Sketch{}
+	fmt.Println(c.UnmarshalBinary(data), c.Count(), len(data))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	linkedlist "github.com/rama-kairi/ds-algo/ds/linked-list"
+)
+
+func main() {
+	l := linkedlist.NewDoubly[int64]()
+
+	two := l.PushBack(2)
+	l.PushBack(4)
+	l.PushFront(1)
+	l.InsertAfter(3, two)
+
+	for v := range l.All() {
+		fmt.Println(v)
+	}
+	for v := range l.Backward() {
+		fmt.Println(v)
+	}
+
+	l.MoveToFront(two)
+	fmt.Println(l.PopFront())
+	fmt.Println(l.PopBack())
+	fmt.Println(l.Len())
+
+	c := linkedlist.NewComparable[string]()
+	c.Insert("c")
+	c.Insert("a")
+	_ = c.InsertAt(1, "b")
+	fmt.Println(c.Contains("b"), c.IndexOf("c"), c.IndexOf("z"))
+	fmt.Println(c.At(5))
+	fmt.Println(c.RemoveValue("a"))
+	fmt.Println(c.At(0))
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/priorityqueue"
+)
+
+type job struct {
+	name     string
+	priority int
+}
+
+func main() {
+	pq := priorityqueue.NewMin[int64]()
+	pq.Push(5)
+	pq.Push(1)
+	pq.Push(3)
+	fmt.Println(pq.Peek())
+	for !pq.IsEmpty() {
+		fmt.Println(pq.Pop())
+	}
+
+	jobs := priorityqueue.New(func(a, b job) bool { return a.priority > b.priority })
+	jobs.Push(job{"backup", 1})
+	report := jobs.Push(job{"report", 2})
+	jobs.Push(job{"deploy", 3})
+	jobs.Update(report, job{"report", 10})
+	for !jobs.IsEmpty() {
+		j, _ := jobs.Pop()
+		fmt.Println(j.name, j.priority)
+	}
+}
@@ -13,7 +13,8 @@ func main() {
 	q.Enqueue(2)
 	q.Enqueue(3)
 
-	q.String()
+	fmt.Println(q)
+	fmt.Printf("%+v\n", q)
 
 	fmt.Println(q.Dequeue())
 	fmt.Println(q.Dequeue())
@@ -24,7 +25,16 @@ func main() {
 	q.Enqueue(1)
 	q.Enqueue(2)
 	q.Enqueue(3)
-	q.String()
+	fmt.Println(q)
 	q.Clear()
-	q.String()
+	fmt.Println(q)
+
+	d := queue.NewDeque[int64]()
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.Rotate(1)
+	fmt.Printf("%v %#v\n", d, d)
+	fmt.Println(d.At(1))
+	fmt.Println(d.PopBack())
 }
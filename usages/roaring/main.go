@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/roaring"
+)
+
+func main() {
+	a := roaring.Of(1, 2, 3, 70000)
+	b := roaring.New()
+	for v := uint32(0); v < 100000; v += 2 {
+		b.Add(v)
+	}
+
+	fmt.Println(a.Contains(70000), b.Len())
+	fmt.Println(a.Intersection(b))
+	fmt.Println(a.Difference(b))
+	fmt.Println(a.Union(b).Len())
+
+	b.RunOptimize()
+	data, _ := b.MarshalBinary()
+	c := roaring.New()
+	fmt.Println(c.UnmarshalBinary(data), c.Equal(b), len(data))
+}
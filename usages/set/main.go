@@ -18,11 +18,25 @@ func main() {
 	s.Add(6)
 	s.Add(6)
 
-	s.String()
+	fmt.Println(s)
 	fmt.Println(s.Contains(2))
 	fmt.Println(s.Filter(func(x int64) bool { return x%2 == 0 }))
 
 	fmt.Println(s.Len())
+	fmt.Println(s.Sorted(func(a, b int64) bool { return a > b }))
+	fmt.Println(set.SortedOrdered(s))
 
-	s.String()
+	fmt.Printf("%+v\n", s)
+
+	o := set.NewOrdered[int64]()
+	for _, v := range []int64{5, 1, 4, 2, 3} {
+		o.Add(v)
+	}
+	fmt.Println(o)
+	fmt.Println(o.Floor(0))
+	fmt.Println(o.Ceiling(3))
+	fmt.Println(o.Select(1))
+	for v := range o.Range(2, 4) {
+		fmt.Println(v)
+	}
 }
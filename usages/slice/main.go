@@ -17,9 +17,10 @@ func main() {
 	s = s.Append(5)
 	s = s.Prepend(0)
 	println(s.Len())
-	fmt.Println("3rd Index Value", s.Get(3))
-	fmt.Println("4th Index Value", s.Get(4))
-	s.Print()
+	fmt.Println(s.Get(3))
+	fmt.Println(s.Get(4))
+	fmt.Println(s.Get(10))
+	fmt.Println(s)
 	s = s.Reverse()
-	s.Print()
+	fmt.Printf("%#v\n", s)
 }
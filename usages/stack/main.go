@@ -16,5 +16,15 @@ func main() {
 	s.Pop()
 	s.Pop()
 
-	s.String()
+	fmt.Println(s)
+
+	b := stack.NewBounded[string](2)
+	b.Push("a")
+	b.Push("b")
+	fmt.Println(b.Push("c"))
+	fmt.Println(b.Len(), b.IsFull())
+	for v := range b.All() {
+		fmt.Println(v)
+	}
+	fmt.Printf("%+v %#v\n", b, b)
 }
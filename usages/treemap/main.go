@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rama-kairi/ds-algo/ds/tree/treemap"
+)
+
+func main() {
+	maps := []treemap.OrderedMap[int, string]{
+		treemap.NewAVL[int, string](),
+		treemap.NewRedBlack[int, string](),
+	}
+	for _, m := range maps {
+		for i := range 10 {
+			m.Put(i*10, fmt.Sprint("v", i*10))
+		}
+		m.Delete(40)
+
+		fmt.Println(m, m.Len(), m.Check())
+		fmt.Println(m.Floor(45))
+		fmt.Println(m.Ceiling(45))
+		fmt.Println(m.Rank(50))
+		fmt.Println(m.Min())
+		fmt.Println(m.Select(3))
+		for k, v := range m.Range(20, 60) {
+			fmt.Print(k, "=", v, " ")
+		}
+		fmt.Println()
+	}
+}